@@ -37,6 +37,7 @@ DEFS=$1
 [[ -r "${DEFS}/units.json" ]]
 [[ -r "${DEFS}/buildings.json" ]]
 [[ -r "${DEFS}/knowledge.json" ]]
+[[ -r "${DEFS}/artifacts.json" ]]
 shift
 
 TRANSLATIONS=$1
@@ -55,6 +56,9 @@ mkdir -p "${OUT}/save"
 mkdir -p "${OUT}/evt"
 
 
+# Validate the definitions of the world
+hege region check "${DEFS}"
+
 # Generate the database for a world
 hege-mapper normalize < "${MAP}" > "${OUT}/map_seed.json"
 hege-init --config "${DEFS}" "${OUT}" < "${OUT}/map_seed.json"
@@ -158,6 +158,8 @@ heged region -v \
   --save "${BASE}/save" \
   --endpoint $ip:8081 \
   --event $ip:8083 \
+  --auth $ip:8082 \
+  --map $ip:8084 \
   &
 
 heged web \
@@ -51,7 +51,7 @@ func (s {{.SetName}}) Swap(i, j int) {
 
 func (s *{{.SetName}}) Add(a {{.ItemType}}) {
 	*s = append(*s, a)
-	if nb := len(*s); nb > 2 && !sort.IsSorted((*s)[nb-2:]) {
+	if nb := len(*s); nb > 1 && !sort.IsSorted((*s)[nb-2:]) {
 		sort.Sort(s)
 	}
 }
@@ -9,6 +9,7 @@ import (
 	"errors"
 	hegemonie_event_client "github.com/jfsmig/hegemonie/pkg/event/client"
 	hegemonie_map_client "github.com/jfsmig/hegemonie/pkg/map/client"
+	hegemonie_region_client "github.com/jfsmig/hegemonie/pkg/region/client"
 	"github.com/jfsmig/hegemonie/pkg/utils"
 	"github.com/spf13/cobra"
 	"log"
@@ -34,16 +35,16 @@ func main() {
 	evtCmd.Use = "event"
 	evtCmd.Aliases = []string{"evt"}
 	rootCmd.AddCommand(evtCmd)
+
+	regCmd := hegemonie_region_client.Command()
+	regCmd.Use = "region"
+	regCmd.Aliases = []string{"reg"}
+	rootCmd.AddCommand(regCmd)
 	/*
 		aaaCmd := hegemonie_auth_client.Command()
 		aaaCmd.Use = "auth"
 		aaaCmd.Aliases = []string{"aaa"}
 		rootCmd.AddCommand(aaaCmd)
-
-		regCmd := hegemonie_region_client.Command()
-		regCmd.Use = "region"
-		regCmd.Aliases = []string{"reg"}
-		rootCmd.AddCommand(regCmd)
 	*/
 
 	if err := rootCmd.Execute(); err != nil {
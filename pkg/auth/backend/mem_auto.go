@@ -0,0 +1,326 @@
+// Code generated : DO NOT EDIT.
+// Code generated : 2026-10-18 11:58:17.873866127 +0000 UTC m=+0.000077711
+
+// Copyright (C) 2018-2020 Hegemonie's AUTHORS
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package hegemonie_auth_backend
+
+import (
+	"sort"
+	"errors"
+)
+
+
+
+type SetOfUsers []*userMem
+
+func (s SetOfUsers) CheckThenFail() {
+	if err := s.Check(); err != nil {
+		panic(err.Error())
+	}
+}
+
+func (s SetOfUsers) Len() int {
+	return len(s)
+}
+
+func (s SetOfUsers) Swap(i, j int) {
+	s[i], s[j] = s[j], s[i]
+}
+
+func (s *SetOfUsers) Add(a *userMem) {
+	*s = append(*s, a)
+	if nb := len(*s); nb > 1 && !sort.IsSorted((*s)[nb-2:]) {
+		sort.Sort(s)
+	}
+}
+
+
+func (s SetOfUsers) Less(i, j int) bool {
+	return s[i].ID < s[j].ID
+}
+
+func (s SetOfUsers) Check() error {
+	if !sort.IsSorted(s) {	
+		return errors.New("Unsorted")
+	}
+	var lastId string
+	for _, a := range s {
+		if lastId == a.ID {
+			return errors.New("Duplicate ID")
+		}
+		lastId = a.ID
+	}
+	return nil
+}
+
+func (s SetOfUsers) Slice(marker string, max uint32) []*userMem {
+	if max == 0 {
+		max = 1000
+	} else if max > 100000 {
+		max = 100000
+	}
+	start := sort.Search(len(s), func(i int) bool {
+		return s[i].ID > marker
+	})
+	if start < 0 || start >= s.Len() {
+		return s[:0]
+	}
+	remaining := uint32(s.Len() - start)
+	if remaining > max {
+		remaining = max
+	}
+	return s[start : uint32(start)+remaining]
+}
+
+func (s SetOfUsers) getIndex(id string) int {
+	i := sort.Search(len(s), func(i int) bool {
+		return s[i].ID >= id
+	})
+	if i < len(s) && s[i].ID == id {
+		return i
+	}
+	return -1
+}
+
+func (s SetOfUsers) Get(id string) *userMem {
+	var out *userMem
+	idx := s.getIndex(id)
+	if idx >= 0 {
+		out = s[idx]
+	}
+	return out
+}
+
+func (s SetOfUsers) Has(id string) bool {
+	return s.getIndex(id) >= 0
+}
+
+func (s *SetOfUsers) Remove(a *userMem) {
+	idx := s.getIndex(a.ID)
+	if idx >= 0 && idx < len(*s) {
+		if len(*s) == 1 {
+			*s = (*s)[:0]
+		} else {
+			s.Swap(idx, s.Len()-1)
+			*s = (*s)[:s.Len()-1]
+			sort.Sort(*s)
+		}
+	}
+}
+
+
+
+type SetOfCharacters []*Character
+
+func (s SetOfCharacters) CheckThenFail() {
+	if err := s.Check(); err != nil {
+		panic(err.Error())
+	}
+}
+
+func (s SetOfCharacters) Len() int {
+	return len(s)
+}
+
+func (s SetOfCharacters) Swap(i, j int) {
+	s[i], s[j] = s[j], s[i]
+}
+
+func (s *SetOfCharacters) Add(a *Character) {
+	*s = append(*s, a)
+	if nb := len(*s); nb > 1 && !sort.IsSorted((*s)[nb-2:]) {
+		sort.Sort(s)
+	}
+}
+
+
+func (s SetOfCharacters) Less(i, j int) bool {
+	p0, p1 := s[i], s[j]
+	return p0.Region < p1.Region || (p0.Region == p1.Region && p0.Name < p1.Name)
+}
+
+func (s SetOfCharacters) First(at string) int {
+	return sort.Search(len(s), func(i int) bool { return s[i].Region >= at })
+}
+
+func (s SetOfCharacters) Check() error {
+	if !sort.IsSorted(s) {
+		return errors.New("Unsorted")
+	}
+	var l0 string
+	var l1 string
+	for _, a := range s {
+		if l0 == a.Region && l1 == a.Name {
+			return errors.New("Duplicate ID")
+		}
+		l0 = a.Region
+	}
+	return nil
+}
+
+func (s SetOfCharacters) Slice(m0 string, m1 string, max uint32) []*Character {
+	if max == 0 {
+		max = 1000
+	} else if max > 100000 {
+		max = 100000
+	}
+
+	iMax := s.Len()
+	start := s.First(m0)
+	for start < iMax && s[start].Region == m0 && s[start].Name <= m1 {
+		start++
+	}
+
+	remaining := uint32(iMax - start)
+	if remaining > max {
+		remaining = max
+	}
+	return s[start : uint32(start)+remaining]
+}
+
+func (s SetOfCharacters) getIndex(f0 string, f1 string) int {
+	i := sort.Search(len(s), func(i int) bool {
+		return s[i].Region >= f0 || (s[i].Region == f0 && s[i].Name >= f1)
+	})
+	if i < len(s) && s[i].Region == f0 && s[i].Name == f1 {
+		return i
+	}
+	return -1
+}
+
+func (s SetOfCharacters) Get(f0 string, f1 string) *Character {
+	var out *Character
+	idx := s.getIndex(f0, f1)
+	if idx >= 0 {
+		out = s[idx]
+	}
+	return out
+}
+
+func (s SetOfCharacters) Has(f0 string, f1 string) bool {
+	return s.getIndex(f0, f1) >= 0
+}
+
+func (s *SetOfCharacters) Remove(a *Character) {
+	idx := s.getIndex(a.Region, a.Name)
+	if idx >= 0 && idx < len(*s) {
+		if len(*s) == 1 {
+			*s = (*s)[:0]
+		} else {
+			s.Swap(idx, s.Len()-1)
+			*s = (*s)[:s.Len()-1]
+			sort.Sort(*s)
+		}
+	}
+}
+
+
+
+type SetOfCharacterNames []charName
+
+func (s SetOfCharacterNames) CheckThenFail() {
+	if err := s.Check(); err != nil {
+		panic(err.Error())
+	}
+}
+
+func (s SetOfCharacterNames) Len() int {
+	return len(s)
+}
+
+func (s SetOfCharacterNames) Swap(i, j int) {
+	s[i], s[j] = s[j], s[i]
+}
+
+func (s *SetOfCharacterNames) Add(a charName) {
+	*s = append(*s, a)
+	if nb := len(*s); nb > 1 && !sort.IsSorted((*s)[nb-2:]) {
+		sort.Sort(s)
+	}
+}
+
+
+func (s SetOfCharacterNames) Less(i, j int) bool {
+	p0, p1 := s[i], s[j]
+	return p0.region < p1.region || (p0.region == p1.region && p0.name < p1.name)
+}
+
+func (s SetOfCharacterNames) First(at string) int {
+	return sort.Search(len(s), func(i int) bool { return s[i].region >= at })
+}
+
+func (s SetOfCharacterNames) Check() error {
+	if !sort.IsSorted(s) {
+		return errors.New("Unsorted")
+	}
+	var l0 string
+	var l1 string
+	for _, a := range s {
+		if l0 == a.region && l1 == a.name {
+			return errors.New("Duplicate ID")
+		}
+		l0 = a.region
+	}
+	return nil
+}
+
+func (s SetOfCharacterNames) Slice(m0 string, m1 string, max uint32) []charName {
+	if max == 0 {
+		max = 1000
+	} else if max > 100000 {
+		max = 100000
+	}
+
+	iMax := s.Len()
+	start := s.First(m0)
+	for start < iMax && s[start].region == m0 && s[start].name <= m1 {
+		start++
+	}
+
+	remaining := uint32(iMax - start)
+	if remaining > max {
+		remaining = max
+	}
+	return s[start : uint32(start)+remaining]
+}
+
+func (s SetOfCharacterNames) getIndex(f0 string, f1 string) int {
+	i := sort.Search(len(s), func(i int) bool {
+		return s[i].region >= f0 || (s[i].region == f0 && s[i].name >= f1)
+	})
+	if i < len(s) && s[i].region == f0 && s[i].name == f1 {
+		return i
+	}
+	return -1
+}
+
+func (s SetOfCharacterNames) Get(f0 string, f1 string) charName {
+	var out charName
+	idx := s.getIndex(f0, f1)
+	if idx >= 0 {
+		out = s[idx]
+	}
+	return out
+}
+
+func (s SetOfCharacterNames) Has(f0 string, f1 string) bool {
+	return s.getIndex(f0, f1) >= 0
+}
+
+func (s *SetOfCharacterNames) Remove(a charName) {
+	idx := s.getIndex(a.region, a.name)
+	if idx >= 0 && idx < len(*s) {
+		if len(*s) == 1 {
+			*s = (*s)[:0]
+		} else {
+			s.Swap(idx, s.Len()-1)
+			*s = (*s)[:s.Len()-1]
+			sort.Sort(*s)
+		}
+	}
+}
+
@@ -6,6 +6,7 @@
 package hegemonie_region_agent
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	grpc_health_v1 "github.com/jfsmig/hegemonie/pkg/healthcheck"
@@ -15,12 +16,17 @@ import (
 	"github.com/spf13/cobra"
 	"google.golang.org/grpc"
 	"net"
+	"time"
 )
 
 type regionConfig struct {
 	endpoint      string
 	endpointEvent string
+	endpointAuth  string
+	endpointMap   string
 	backend       string
+	tick          time.Duration
+	save          time.Duration
 }
 
 func Command() *cobra.Command {
@@ -38,8 +44,16 @@ func Command() *cobra.Command {
 		"endpoint", utils.DefaultEndpointRegion, "IP:PORT endpoint for the TCP/IP server")
 	agent.Flags().StringVar(&cfg.endpointEvent,
 		"event", utils.DefaultEndpointEvent, "Address of the Event server to connect to.")
+	agent.Flags().StringVar(&cfg.endpointAuth,
+		"auth", utils.DefaultEndpointAuth, "Address of the Auth server to connect to.")
+	agent.Flags().StringVar(&cfg.endpointMap,
+		"map", utils.DefaultEndpointMap, "Address of the Map server to connect to.")
 	agent.Flags().StringVar(&cfg.backend,
 		"defs", "", "Path to the file with the definition of the world.")
+	agent.Flags().DurationVar(&cfg.tick,
+		"tick", 10*time.Second, "How often the schedules of the regions are checked. 0 disables the automatic rounds.")
+	agent.Flags().DurationVar(&cfg.save,
+		"save", time.Minute, "How often all the regions are saved. 0 disables the periodic saves.")
 
 	return agent
 }
@@ -59,13 +73,33 @@ func (cfg *regionConfig) execute() error {
 		return err
 	}
 
+	err = w.LoadRegions(cfg.backend)
+	if err != nil {
+		return err
+	}
+
 	err = w.PostLoad()
 	if err != nil {
 		return fmt.Errorf("Inconsistent World from [%s]: %v", cfg.backend, err)
 	}
 
+	var cnxMap *grpc.ClientConn
+	cnxMap, err = grpc.Dial(cfg.endpointMap, grpc.WithInsecure())
+	if err != nil {
+		return err
+	}
+	defer cnxMap.Close()
+	maps := NewMapClient(cnxMap)
+	w.SetMapView(maps)
+
 	err = w.Check()
 	if err != nil {
+		var errs region.DefinitionErrors
+		if errors.As(err, &errs) {
+			for _, e := range errs {
+				utils.Logger.Error().Err(e).Msg("Invalid definitions")
+			}
+		}
 		return fmt.Errorf("Inconsistent World from [%s]: %v", cfg.backend, err)
 	}
 
@@ -82,13 +116,30 @@ func (cfg *regionConfig) execute() error {
 	defer cnxEvent.Close()
 	w.SetNotifier(&EventStore{cnx: cnxEvent})
 
+	var cnxAuth *grpc.ClientConn
+	cnxAuth, err = grpc.Dial(cfg.endpointAuth, grpc.WithInsecure())
+	if err != nil {
+		return err
+	}
+	defer cnxAuth.Close()
+
+	saver := &regionSaver{backend: cfg.backend}
+
 	srv := grpc.NewServer(utils.ServerUnaryInterceptorZerolog())
-	rproto.RegisterCityServer(srv, &srvCity{cfg: cfg, w: &w})
+	rproto.RegisterCityServer(srv, &srvCity{cfg: cfg, w: &w, cnxAuth: cnxAuth})
 	rproto.RegisterDefinitionsServer(srv, &srvDefinitions{cfg: cfg, w: &w})
-	rproto.RegisterAdminServer(srv, &srvAdmin{cfg: cfg, w: &w})
+	rproto.RegisterAdminServer(srv, &srvAdmin{cfg: cfg, w: &w, maps: maps, saver: saver})
 	rproto.RegisterArmyServer(srv, &srvArmy{cfg: cfg, w: &w})
+	rproto.RegisterDiplomacyServer(srv, &srvDiplomacy{cfg: cfg, w: &w})
+	rproto.RegisterMarketServer(srv, &srvMarket{cfg: cfg, w: &w})
 	grpc_health_v1.RegisterHealthServer(srv, &srvHealth{w: &w})
 
+	if cfg.tick > 0 || cfg.save > 0 {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		go runScheduler(ctx, &w, saver, cfg.tick, cfg.save)
+	}
+
 	if err := srv.Serve(lis); err != nil {
 		return fmt.Errorf("failed to serve: %v", err)
 	}
@@ -36,6 +36,11 @@ type EventArmy struct {
 	Dst uint64 `json:"Dst"`
 
 	Action string `json:"action"`
+
+	Resources   *region.Resources `json:"Resources,omitempty"`
+	NbUnits     int               `json:"Units,omitempty"`
+	NbArtifacts int               `json:"Artifacts,omitempty"`
+	PrevName    string            `json:"Prev,omitempty"`
 }
 
 type EventKnowledge struct {
@@ -46,6 +51,34 @@ type EventUnits struct {
 	store *EventStore
 }
 
+type EventCity struct {
+	store  *EventStore
+	charID string
+
+	CityID   uint64 `json:"CityId"`
+	CityName string `json:"City"`
+
+	Action string            `json:"action"`
+	Prev   string            `json:"Prev"`
+	Next   string            `json:"Next"`
+	ItemID string            `json:"ItemId,omitempty"`
+	Refund *region.Resources `json:"Refund,omitempty"`
+}
+
+type EventSpy struct {
+	store  *EventStore
+	charID string
+
+	ActorCityID   uint64 `json:"ActorCityId"`
+	ActorCity     string `json:"ActorCity"`
+	VictimCityID  uint64 `json:"VictimCityId"`
+	VictimCity    string `json:"VictimCity"`
+	KnowledgeType uint64 `json:"KnowledgeType,omitempty"`
+
+	Action  string `json:"action"`
+	Success bool   `json:"Success"`
+}
+
 func (es *EventStore) Army(log *region.City) region.EventArmy {
 	return &EventArmy{
 		store:        es,
@@ -63,6 +96,28 @@ func (es *EventStore) Units(log *region.City) region.EventUnits {
 	return &EventUnits{store: es}
 }
 
+func (es *EventStore) Spy(log *region.City) region.EventSpy {
+	return &EventSpy{store: es, charID: log.Owner}
+}
+
+func (es *EventStore) City(to string) region.EventCity {
+	return &EventCity{store: es, charID: to}
+}
+
+func (es *EventStore) push(charID string, evt interface{}) {
+	var buffer bytes.Buffer
+	enc := json.NewEncoder(&buffer)
+	enc.SetIndent("", "")
+	enc.Encode(evt)
+
+	client := hegemonie_rpevent_proto.NewProducerClient(es.cnx)
+	client.Push1(context.Background(), &hegemonie_rpevent_proto.Push1Req{
+		CharId:  charID,
+		EvtId:   uuid.New().String(),
+		Payload: buffer.Bytes(),
+	})
+}
+
 func (evt *EventArmy) Item(a *region.Army) region.EventArmy {
 	evt.ArmyID = a.ID
 	evt.ArmyName = a.Name
@@ -83,18 +138,35 @@ func (evt *EventArmy) NoRoute(src, dst uint64) region.EventArmy {
 	return evt
 }
 
-func (evt *EventArmy) Send() {
-	var buffer bytes.Buffer
-	enc := json.NewEncoder(&buffer)
-	enc.SetIndent("", "")
-	enc.Encode(evt)
+func (evt *EventArmy) Flea(cell uint64) region.EventArmy {
+	evt.Src, evt.Dst = cell, cell
+	evt.Action = "Flea"
+	return evt
+}
 
-	client := hegemonie_rpevent_proto.NewProducerClient(evt.store.cnx)
-	client.Push1(context.Background(), &hegemonie_rpevent_proto.Push1Req{
-		CharId:  evt.charID,
-		EvtId:   uuid.New().String(),
-		Payload: buffer.Bytes(),
-	})
+func (evt *EventArmy) Flip(cell uint64) region.EventArmy {
+	evt.Src, evt.Dst = cell, cell
+	evt.Action = "Flip"
+	return evt
+}
+
+func (evt *EventArmy) Deliver(cell uint64, r region.Resources, nbUnits, nbArtifacts int) region.EventArmy {
+	evt.Src, evt.Dst = cell, cell
+	evt.Action = "Deliver"
+	evt.Resources = &r
+	evt.NbUnits = nbUnits
+	evt.NbArtifacts = nbArtifacts
+	return evt
+}
+
+func (evt *EventArmy) Rename(prev string) region.EventArmy {
+	evt.Action = "Rename"
+	evt.PrevName = prev
+	return evt
+}
+
+func (evt *EventArmy) Send() {
+	evt.store.push(evt.charID, evt)
 }
 
 func (evt *EventKnowledge) Item(c *region.City, kt *region.KnowledgeType) region.EventKnowledge {
@@ -124,3 +196,55 @@ func (evt *EventUnits) Step(current, max uint64) region.EventUnits {
 func (evt *EventUnits) Send() {
 	// TODO FIXME
 }
+
+func (evt *EventSpy) Item(actor, victim *region.City) region.EventSpy {
+	evt.ActorCityID, evt.ActorCity = actor.ID, actor.Name
+	evt.VictimCityID, evt.VictimCity = victim.ID, victim.Name
+	return evt
+}
+
+func (evt *EventSpy) Reveal(success bool) region.EventSpy {
+	evt.Action = "Reveal"
+	evt.Success = success
+	return evt
+}
+
+func (evt *EventSpy) Steal(kt *region.KnowledgeType, success bool) region.EventSpy {
+	evt.Action = "Steal"
+	evt.KnowledgeType = kt.ID
+	evt.Success = success
+	return evt
+}
+
+func (evt *EventSpy) Send() {
+	evt.store.push(evt.charID, evt)
+}
+
+func (evt *EventCity) Item(c *region.City) region.EventCity {
+	evt.CityID, evt.CityName = c.ID, c.Name
+	return evt
+}
+
+func (evt *EventCity) Deputy(prev, next string) region.EventCity {
+	evt.Action, evt.Prev, evt.Next = "Deputy", prev, next
+	return evt
+}
+
+func (evt *EventCity) Owner(prev, next string) region.EventCity {
+	evt.Action, evt.Prev, evt.Next = "Owner", prev, next
+	return evt
+}
+
+func (evt *EventCity) Rename(prev, next string) region.EventCity {
+	evt.Action, evt.Prev, evt.Next = "Rename", prev, next
+	return evt
+}
+
+func (evt *EventCity) Cancel(id string, r region.Resources) region.EventCity {
+	evt.Action, evt.ItemID, evt.Refund = "Cancel", id, &r
+	return evt
+}
+
+func (evt *EventCity) Send() {
+	evt.store.push(evt.charID, evt)
+}
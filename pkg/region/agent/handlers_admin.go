@@ -15,8 +15,10 @@ import (
 )
 
 type srvAdmin struct {
-	cfg *regionConfig
-	w   *region.World
+	cfg   *regionConfig
+	w     *region.World
+	maps  *MapClient
+	saver *regionSaver
 }
 
 var none = &proto.None{}
@@ -33,32 +35,49 @@ func (srv *srvAdmin) wlockDo(action func() error) error {
 	return action()
 }
 
-func (s *srvAdmin) Produce(ctx context.Context, req *proto.RegionId) (*proto.None, error) {
-	return none, s.rlockDo(func() error {
-		r := s.w.Regions.Get(req.Region)
+// Alter the Region then save it, so that the change and the tick counters
+// of its Schedule survive a restart of the agent. The Region is written on
+// disk once the World is unlocked.
+func (s *srvAdmin) updateDo(id string, action func(r *region.Region)) error {
+	return s.saver.update(s.w, func() ([]*region.Region, error) {
+		r := s.w.Regions.Get(id)
 		if r == nil {
-			return status.Error(codes.NotFound, "No such region")
+			return nil, status.Error(codes.NotFound, "No such region")
 		}
+		action(r)
+		return []*region.Region{r}, nil
+	})
+}
+
+func (s *srvAdmin) Produce(ctx context.Context, req *proto.RegionId) (*proto.None, error) {
+	return none, s.updateDo(req.Region, func(r *region.Region) {
 		r.Produce()
-		return nil
 	})
 }
 
 func (s *srvAdmin) Move(ctx context.Context, req *proto.RegionId) (*proto.None, error) {
-	return none, s.rlockDo(func() error {
-		r := s.w.Regions.Get(req.Region)
-		if r == nil {
-			return status.Error(codes.NotFound, "No such region")
-		}
+	return none, s.updateDo(req.Region, func(r *region.Region) {
 		r.Move()
-		return nil
 	})
 }
 
+// Create a Region with one City per site of its map, then save it.
+// The World is locked by the model during the creation of the Region, then
+// by the saver during the snapshot, so that the Region is written in order
+// with the other saves.
 func (s *srvAdmin) CreateRegion(ctx context.Context, req *proto.RegionCreateReq) (*proto.None, error) {
-	return none, s.wlockDo(func() error {
-		_, err := s.w.CreateRegion(req.Name, req.MapName)
-		return err
+	sites, err := s.maps.Cities(ctx, req.MapName)
+	if err != nil {
+		return none, err
+	}
+
+	r, err := s.w.CreateRegionFromSites(req.Name, req.MapName, sites)
+	if err != nil {
+		return none, err
+	}
+
+	return none, s.saver.update(s.w, func() ([]*region.Region, error) {
+		return []*region.Region{r}, nil
 	})
 }
 
@@ -80,3 +99,64 @@ func (s *srvAdmin) GetScores(req *proto.RegionId, stream proto.Admin_GetScoresSe
 		return nil
 	})
 }
+
+func (s *srvAdmin) CreateArtifact(ctx context.Context, req *proto.ArtifactCreateReq) (*proto.None, error) {
+	return none, s.wlockDo(func() error {
+		r := s.w.Regions.Get(req.Region)
+		if r == nil {
+			return status.Error(codes.NotFound, "No such region")
+		}
+		city := r.CityGet(req.City)
+		if city == nil {
+			return status.Error(codes.NotFound, "No such city")
+		}
+		_, err := city.ArtifactCreate(r, req.Type, req.Name)
+		return err
+	})
+}
+
+func (s *srvAdmin) SeedEpidemic(ctx context.Context, req *proto.EpidemicSeedReq) (*proto.None, error) {
+	return none, s.wlockDo(func() error {
+		r := s.w.Regions.Get(req.Region)
+		if r == nil {
+			return status.Error(codes.NotFound, "No such region")
+		}
+		city := r.CityGet(req.City)
+		if city == nil {
+			return status.Error(codes.NotFound, "No such city")
+		}
+		city.Infect(s.w)
+		return nil
+	})
+}
+
+func (s *srvAdmin) GetSchedule(ctx context.Context, req *proto.RegionId) (*proto.ScheduleView, error) {
+	var view *proto.ScheduleView
+	err := s.rlockDo(func() error {
+		r := s.w.Regions.Get(req.Region)
+		if r == nil {
+			return status.Error(codes.NotFound, "No such region")
+		}
+		view = ShowSchedule(&r.Schedule)
+		return nil
+	})
+	return view, err
+}
+
+func (s *srvAdmin) SetSchedule(ctx context.Context, req *proto.ScheduleReq) (*proto.None, error) {
+	return none, s.updateDo(req.Region, func(r *region.Region) {
+		r.SetSchedule(req.MovePeriod, req.ProducePeriod)
+	})
+}
+
+func (s *srvAdmin) PauseSchedule(ctx context.Context, req *proto.RegionId) (*proto.None, error) {
+	return none, s.updateDo(req.Region, func(r *region.Region) {
+		r.PauseSchedule(true)
+	})
+}
+
+func (s *srvAdmin) ResumeSchedule(ctx context.Context, req *proto.RegionId) (*proto.None, error) {
+	return none, s.updateDo(req.Region, func(r *region.Region) {
+		r.PauseSchedule(false)
+	})
+}
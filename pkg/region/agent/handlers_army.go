@@ -23,7 +23,7 @@ func (s *srvArmy) getAndCheckArmy(req *proto.ArmyId) (*region.Region, *region.Ci
 	if r == nil {
 		return nil, nil, nil, status.Error(codes.NotFound, "no such region")
 	}
-	city, err := r.CityGetAndCheck(req.Character, req.City)
+	city, err := r.CityGetAndCheck(req.City, req.Character)
 	if err != nil {
 		return nil, nil, nil, status.Error(codes.NotFound, "no such city")
 	}
@@ -88,7 +88,7 @@ func (s *srvArmy) Flip(ctx context.Context, req *proto.ArmyId) (*proto.None, err
 func (s *srvArmy) Move(ctx context.Context, req *proto.ArmyMoveReq) (*proto.None, error) {
 	return &proto.None{}, s.wlockDo(req.Id,
 		func(r *region.Region, _ *region.City, army *region.Army) error {
-			return army.DeferMove(r, req.Target, region.ActionArgMove{})
+			return army.DeferMove(r, req.Target, moveArgsP2M(req.Args))
 		})
 }
 
@@ -126,3 +126,31 @@ func (s *srvArmy) Cancel(ctx context.Context, req *proto.ArmyId) (*proto.None, e
 			return army.Cancel(r)
 		})
 }
+
+func (s *srvArmy) SetPostureCity(ctx context.Context, req *proto.ArmyPostureCityReq) (*proto.None, error) {
+	return &proto.None{}, s.wlockDo(req.Id,
+		func(r *region.Region, _ *region.City, army *region.Army) error {
+			return army.SetPostureCity(req.City, req.Value)
+		})
+}
+
+func (s *srvArmy) SetPostureCharacter(ctx context.Context, req *proto.ArmyPostureCharacterReq) (*proto.None, error) {
+	return &proto.None{}, s.wlockDo(req.Id,
+		func(r *region.Region, _ *region.City, army *region.Army) error {
+			return army.SetPostureCharacter(req.Character, req.Value)
+		})
+}
+
+func (s *srvArmy) DropArtifact(ctx context.Context, req *proto.ArmyArtifactReq) (*proto.None, error) {
+	return &proto.None{}, s.wlockDo(req.Id,
+		func(r *region.Region, _ *region.City, army *region.Army) error {
+			return army.DropArtifact(r, req.Artifact)
+		})
+}
+
+func (s *srvArmy) Rename(ctx context.Context, req *proto.ArmyRenameReq) (*proto.None, error) {
+	return &proto.None{}, s.wlockDo(req.Id,
+		func(r *region.Region, _ *region.City, army *region.Army) error {
+			return army.Rename(r, req.Name)
+		})
+}
@@ -7,8 +7,10 @@ package hegemonie_region_agent
 
 import (
 	"context"
+	auth "github.com/jfsmig/hegemonie/pkg/auth/proto"
 	"github.com/jfsmig/hegemonie/pkg/region/model"
 	"github.com/jfsmig/hegemonie/pkg/utils"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"io"
@@ -17,8 +19,49 @@ import (
 )
 
 type srvCity struct {
-	cfg *regionConfig
-	w   *region.World
+	cfg     *regionConfig
+	w       *region.World
+	cnxAuth *grpc.ClientConn
+}
+
+// Check with the Auth service that the Character exists and is active in the Region.
+func (s *srvCity) checkCharacter(ctx context.Context, idRegion, idChar string) error {
+	cli := auth.NewCharacterClient(s.cnxAuth)
+	view, err := cli.Show(ctx, &auth.CharacterId{Region: idRegion, Name: idChar})
+	if err != nil {
+		return status.Error(codes.NotFound, "No such character")
+	}
+	switch view.State {
+	case auth.CharacterState_CharacterActive, auth.CharacterState_CharacterSuper:
+		return nil
+	default:
+		return status.Error(codes.FailedPrecondition, "Inactive character")
+	}
+}
+
+type cityAction func(*region.Region, *region.City) error
+
+func (s *srvCity) wlockDo(id *proto.CityId, action cityAction) error {
+	return cityWlockDo(s.w, id, action)
+}
+
+// Run the action on the City, with the World locked for writing, once
+// checked that the City belongs to the Character.
+func cityWlockDo(w *region.World, id *proto.CityId, action cityAction) error {
+	w.WLock()
+	defer w.WUnlock()
+
+	r := w.Regions.Get(id.GetRegion())
+	if r == nil {
+		return status.Error(codes.NotFound, "No such region")
+	}
+
+	city, err := r.CityGetAndCheck(id.GetCity(), id.GetCharacter())
+	if err != nil {
+		return status.Error(codes.NotFound, "No such city")
+	}
+
+	return action(r, city)
 }
 
 func (s *srvCity) List(req *proto.CitiesByCharReq, stream proto.City_ListServer) error {
@@ -95,6 +138,7 @@ func (s *srvCity) Show(ctx context.Context, req *proto.CityId) (*proto.CityView,
 	}
 
 	view := ShowCity(s.w, city)
+	view.Schedule = ShowSchedule(&r.Schedule)
 	utils.Logger.Debug().
 		Int("#a", len(view.Assets.Armies)).
 		Int("#k", len(view.Assets.Knowledges)).
@@ -107,57 +151,24 @@ func (s *srvCity) Show(ctx context.Context, req *proto.CityId) (*proto.CityView,
 }
 
 func (s *srvCity) Study(ctx context.Context, req *proto.StudyReq) (*proto.None, error) {
-	s.w.WLock()
-	defer s.w.WUnlock()
-
-	r := s.w.Regions.Get(req.GetCity().GetRegion())
-	if r == nil {
-		return nil, status.Error(codes.NotFound, "No such region")
-	}
-
-	city, err := r.CityGetAndCheck(req.GetCity().GetCity(), req.GetCity().GetCharacter())
-	if err != nil {
-		return nil, status.Error(codes.NotFound, "No such city")
-	}
-
-	_, err = city.Study(r, req.KnowledgeType)
-	return none, nil
+	return none, s.wlockDo(req.City, func(r *region.Region, c *region.City) error {
+		_, err := c.Study(r, req.KnowledgeType)
+		return err
+	})
 }
 
 func (s *srvCity) Build(ctx context.Context, req *proto.BuildReq) (*proto.None, error) {
-	s.w.WLock()
-	defer s.w.WUnlock()
-
-	r := s.w.Regions.Get(req.GetCity().GetRegion())
-	if r == nil {
-		return nil, status.Error(codes.NotFound, "No such region")
-	}
-
-	city, err := r.CityGetAndCheck(req.GetCity().GetCity(), req.GetCity().GetCharacter())
-	if err != nil {
-		return nil, status.Error(codes.NotFound, "No such city")
-	}
-
-	_, err = city.Build(r, req.BuildingType)
-	return none, nil
+	return none, s.wlockDo(req.City, func(r *region.Region, c *region.City) error {
+		_, err := c.Build(r, req.BuildingType)
+		return err
+	})
 }
 
 func (s *srvCity) Train(ctx context.Context, req *proto.TrainReq) (*proto.None, error) {
-	s.w.WLock()
-	defer s.w.WUnlock()
-
-	r := s.w.Regions.Get(req.GetCity().GetRegion())
-	if r == nil {
-		return nil, status.Error(codes.NotFound, "No such region")
-	}
-
-	city, err := r.CityGetAndCheck(req.GetCity().GetCity(), req.GetCity().GetCharacter())
-	if err != nil {
-		return nil, status.Error(codes.NotFound, "No such city")
-	}
-
-	_, err = city.Train(r, req.UnitType)
-	return none, nil
+	return none, s.wlockDo(req.City, func(r *region.Region, c *region.City) error {
+		_, err := c.Train(r, req.UnitType)
+		return err
+	})
 }
 
 func (s *srvCity) ListArmies(req *proto.CityId, stream proto.City_ListArmiesServer) error {
@@ -277,3 +288,206 @@ func (s *srvCity) TransferResources(ctx context.Context, req *proto.TransferReso
 	err = city.TransferOwnResources(army, resAbsP2M(req.Stock))
 	return none, err
 }
+
+func (s *srvCity) ListArtifacts(req *proto.CityId, stream proto.City_ListArtifactsServer) error {
+	s.w.RLock()
+	defer s.w.RUnlock()
+
+	r := s.w.Regions.Get(req.GetRegion())
+	if r == nil {
+		return status.Error(codes.NotFound, "No such region")
+	}
+
+	city, err := r.CityGetAndCheck(req.GetCity(), req.GetCharacter())
+	if err != nil {
+		return status.Error(codes.NotFound, "No such city")
+	}
+
+	for _, a := range city.Artifacts {
+		err = stream.Send(ShowArtifact(a))
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *srvCity) ListPopularity(req *proto.CityId, stream proto.City_ListPopularityServer) error {
+	s.w.RLock()
+	defer s.w.RUnlock()
+
+	r := s.w.Regions.Get(req.GetRegion())
+	if r == nil {
+		return status.Error(codes.NotFound, "No such region")
+	}
+
+	city, err := r.CityGetAndCheck(req.GetCity(), req.GetCharacter())
+	if err != nil {
+		return status.Error(codes.NotFound, "No such city")
+	}
+
+	for _, pc := range city.PopularityHistory {
+		err = stream.Send(ShowPopularityChange(pc))
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *srvCity) TransferArtifact(ctx context.Context, req *proto.TransferArtifactReq) (*proto.None, error) {
+	return none, s.wlockDo(req.City, func(r *region.Region, c *region.City) error {
+		army := c.Armies.Get(req.Army)
+		if army == nil {
+			return status.Error(codes.NotFound, "No such army")
+		}
+		return c.TransferOwnArtifact(army, req.Artifact)
+	})
+}
+
+func (s *srvCity) SpyReveal(ctx context.Context, req *proto.SpyReq) (*proto.SpyReport, error) {
+	var report *proto.SpyReport
+	err := s.wlockDo(req.City, func(r *region.Region, c *region.City) error {
+		target := r.CityGet(req.Target)
+		if target == nil {
+			return status.Error(codes.NotFound, "No such target")
+		}
+		ok, err := c.SpyReveal(r, target)
+		if err != nil {
+			return err
+		}
+		report = &proto.SpyReport{Success: ok}
+		if ok {
+			report.View = ShowCity(s.w, target)
+		}
+		return nil
+	})
+	return report, err
+}
+
+func (s *srvCity) SpySteal(ctx context.Context, req *proto.SpyStealReq) (*proto.SpyReport, error) {
+	var report *proto.SpyReport
+	err := s.wlockDo(req.City, func(r *region.Region, c *region.City) error {
+		target := r.CityGet(req.Target)
+		if target == nil {
+			return status.Error(codes.NotFound, "No such target")
+		}
+		ok, err := c.SpySteal(r, target, req.KnowledgeType)
+		if err != nil {
+			return err
+		}
+		report = &proto.SpyReport{Success: ok}
+		return nil
+	})
+	return report, err
+}
+
+func (s *srvCity) SetAuto(ctx context.Context, req *proto.CityAutoReq) (*proto.None, error) {
+	return none, s.wlockDo(req.City, func(r *region.Region, c *region.City) error {
+		c.Auto = req.Auto
+		return nil
+	})
+}
+
+func (s *srvCity) SetDeputy(ctx context.Context, req *proto.CityDeputyReq) (*proto.None, error) {
+	if req.Deputy != "" {
+		if err := s.checkCharacter(ctx, req.GetCity().GetRegion(), req.Deputy); err != nil {
+			return none, err
+		}
+	}
+	return none, s.wlockDo(req.City, func(r *region.Region, c *region.City) error {
+		return c.SetDeputy(r, req.GetCity().GetCharacter(), req.Deputy)
+	})
+}
+
+func (s *srvCity) TransferOwnership(ctx context.Context, req *proto.CityTransferReq) (*proto.None, error) {
+	if err := s.checkCharacter(ctx, req.GetCity().GetRegion(), req.Owner); err != nil {
+		return none, err
+	}
+	return none, s.wlockDo(req.City, func(r *region.Region, c *region.City) error {
+		return c.TransferOwnership(r, req.GetCity().GetCharacter(), req.Owner)
+	})
+}
+
+func (s *srvCity) Rename(ctx context.Context, req *proto.CityRenameReq) (*proto.None, error) {
+	return none, s.wlockDo(req.City, func(r *region.Region, c *region.City) error {
+		return c.Rename(r, req.Name)
+	})
+}
+
+func (s *srvCity) Dismantle(ctx context.Context, req *proto.DismantleReq) (*proto.None, error) {
+	return none, s.wlockDo(req.City, func(r *region.Region, c *region.City) error {
+		return c.Dismantle(r, req.Building)
+	})
+}
+
+func (s *srvCity) DisbandUnits(ctx context.Context, req *proto.DisbandUnitsReq) (*proto.None, error) {
+	return none, s.wlockDo(req.City, func(r *region.Region, c *region.City) error {
+		return c.DisbandUnits(r, req.Unit...)
+	})
+}
+
+func (s *srvCity) SetQueueOrder(ctx context.Context, req *proto.QueueOrderReq) (*proto.None, error) {
+	return none, s.wlockDo(req.City, func(r *region.Region, c *region.City) error {
+		return c.SetQueueOrder(r, req.Item...)
+	})
+}
+
+func (s *srvCity) PauseItem(ctx context.Context, req *proto.PauseItemReq) (*proto.None, error) {
+	return none, s.wlockDo(req.City, func(r *region.Region, c *region.City) error {
+		return c.PauseItem(r, req.Item, req.Paused)
+	})
+}
+
+func (s *srvCity) SetQueueBudget(ctx context.Context, req *proto.QueueBudgetReq) (*proto.None, error) {
+	var budget [region.QueueMax]float64
+	budget[region.QueueUnits] = req.GetBudget().GetUnits()
+	budget[region.QueueBuildings] = req.GetBudget().GetBuildings()
+	budget[region.QueueKnowledges] = req.GetBudget().GetKnowledges()
+	return none, s.wlockDo(req.City, func(r *region.Region, c *region.City) error {
+		return c.SetQueueBudget(budget)
+	})
+}
+
+func (s *srvCity) CancelBuilding(ctx context.Context, req *proto.CancelReq) (*proto.None, error) {
+	return none, s.wlockDo(req.City, func(r *region.Region, c *region.City) error {
+		return c.CancelBuilding(r, req.Item)
+	})
+}
+
+func (s *srvCity) CancelKnowledge(ctx context.Context, req *proto.CancelReq) (*proto.None, error) {
+	return none, s.wlockDo(req.City, func(r *region.Region, c *region.City) error {
+		return c.CancelKnowledge(r, req.Item)
+	})
+}
+
+func (s *srvCity) CancelUnit(ctx context.Context, req *proto.CancelReq) (*proto.None, error) {
+	return none, s.wlockDo(req.City, func(r *region.Region, c *region.City) error {
+		return c.CancelUnit(r, req.Item)
+	})
+}
+
+func (s *srvCity) Claim(ctx context.Context, req *proto.CityClaimReq) (*proto.PublicCity, error) {
+	if err := s.checkCharacter(ctx, req.Region, req.Character); err != nil {
+		return nil, err
+	}
+
+	s.w.WLock()
+	defer s.w.WUnlock()
+
+	r := s.w.Regions.Get(req.Region)
+	if r == nil {
+		return nil, status.Error(codes.NotFound, "No such region")
+	}
+	c, err := r.ClaimCity(req.Character, claimStrategyP2M(req.Strategy), req.Cell)
+	if err != nil {
+		return nil, err
+	}
+	return ShowCityPublic(s.w, c, false), nil
+}
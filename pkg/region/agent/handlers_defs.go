@@ -28,8 +28,7 @@ func (s *srvDefinitions) ListUnits(req *proto.PaginatedQuery, stream proto.Defin
 		}
 		for _, i := range tab {
 			last = i.ID
-			err := stream.Send(&proto.UnitTypeView{
-				Id: i.ID, Name: i.Name, Ticks: i.Ticks, Health: i.Health})
+			err := stream.Send(ShowUnitType(i))
 			if err == io.EOF {
 				return nil
 			}
@@ -87,3 +86,26 @@ func (s *srvDefinitions) ListKnowledges(req *proto.PaginatedQuery, stream proto.
 		}
 	}
 }
+
+func (s *srvDefinitions) ListArtifacts(req *proto.PaginatedQuery, stream proto.Definitions_ListArtifactsServer) error {
+	s.w.RLock()
+	defer s.w.RUnlock()
+
+	last := req.GetMarker()
+	for {
+		tab := s.w.Definitions.Artifacts.Slice(last, 100)
+		if len(tab) <= 0 {
+			return nil
+		}
+		for _, i := range tab {
+			last = i.ID
+			err := stream.Send(ShowArtifactType(i))
+			if err == io.EOF {
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
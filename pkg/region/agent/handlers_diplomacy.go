@@ -0,0 +1,81 @@
+// Copyright (C) 2018-2020 Hegemonie's AUTHORS
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package hegemonie_region_agent
+
+import (
+	"context"
+	"github.com/jfsmig/hegemonie/pkg/region/model"
+	proto "github.com/jfsmig/hegemonie/pkg/region/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"io"
+)
+
+type srvDiplomacy struct {
+	cfg *regionConfig
+	w   *region.World
+}
+
+func (s *srvDiplomacy) List(req *proto.TreatyListReq, stream proto.Diplomacy_ListServer) error {
+	s.w.RLock()
+	defer s.w.RUnlock()
+
+	r := s.w.Regions.Get(req.Region)
+	if r == nil {
+		return status.Error(codes.NotFound, "No such region")
+	}
+
+	for _, t := range r.TreatiesOf(req.Character) {
+		err := stream.Send(ShowTreaty(t))
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *srvDiplomacy) Propose(ctx context.Context, req *proto.TreatyProposeReq) (*proto.TreatyView, error) {
+	s.w.WLock()
+	defer s.w.WUnlock()
+
+	r := s.w.Regions.Get(req.Region)
+	if r == nil {
+		return nil, status.Error(codes.NotFound, "No such region")
+	}
+
+	t, err := r.TreatyPropose(req.Character, req.Target, treatyKindP2M(req.Kind))
+	if err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	return ShowTreaty(t), nil
+}
+
+func (s *srvDiplomacy) Accept(ctx context.Context, req *proto.TreatyReq) (*proto.None, error) {
+	s.w.WLock()
+	defer s.w.WUnlock()
+
+	r := s.w.Regions.Get(req.Region)
+	if r == nil {
+		return none, status.Error(codes.NotFound, "No such region")
+	}
+
+	return none, r.TreatyAccept(req.Id, req.Character)
+}
+
+func (s *srvDiplomacy) Break(ctx context.Context, req *proto.TreatyReq) (*proto.None, error) {
+	s.w.WLock()
+	defer s.w.WUnlock()
+
+	r := s.w.Regions.Get(req.Region)
+	if r == nil {
+		return none, status.Error(codes.NotFound, "No such region")
+	}
+
+	return none, r.TreatyBreak(req.Id, req.Character)
+}
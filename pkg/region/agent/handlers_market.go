@@ -0,0 +1,76 @@
+// Copyright (C) 2018-2020 Hegemonie's AUTHORS
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package hegemonie_region_agent
+
+import (
+	"context"
+	"github.com/jfsmig/hegemonie/pkg/region/model"
+	proto "github.com/jfsmig/hegemonie/pkg/region/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"io"
+)
+
+type srvMarket struct {
+	cfg *regionConfig
+	w   *region.World
+}
+
+func (s *srvMarket) wlockDo(id *proto.CityId, action cityAction) error {
+	return cityWlockDo(s.w, id, action)
+}
+
+func (s *srvMarket) List(req *proto.MarketListReq, stream proto.Market_ListServer) error {
+	s.w.RLock()
+	defer s.w.RUnlock()
+
+	r := s.w.Regions.Get(req.Region)
+	if r == nil {
+		return status.Error(codes.NotFound, "No such region")
+	}
+
+	last := req.Marker
+	for {
+		tab := r.Market.Slice(last, 100)
+		if len(tab) <= 0 {
+			return nil
+		}
+		for _, o := range tab {
+			last = o.ID
+			err := stream.Send(ShowOffer(o))
+			if err == io.EOF {
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (s *srvMarket) Post(ctx context.Context, req *proto.MarketPostReq) (*proto.OfferView, error) {
+	var rc *proto.OfferView
+	err := s.wlockDo(req.City, func(r *region.Region, c *region.City) error {
+		o, err := c.MarketPost(r, resAbsP2M(req.Goods), resAbsP2M(req.Price))
+		if err == nil {
+			rc = ShowOffer(o)
+		}
+		return err
+	})
+	return rc, err
+}
+
+func (s *srvMarket) Accept(ctx context.Context, req *proto.MarketOfferReq) (*proto.None, error) {
+	return none, s.wlockDo(req.City, func(r *region.Region, c *region.City) error {
+		return c.MarketAccept(r, req.Offer)
+	})
+}
+
+func (s *srvMarket) Cancel(ctx context.Context, req *proto.MarketOfferReq) (*proto.None, error) {
+	return none, s.wlockDo(req.City, func(r *region.Region, c *region.City) error {
+		return c.MarketCancel(r, req.Offer)
+	})
+}
@@ -0,0 +1,170 @@
+// Copyright (C) 2018-2020 Hegemonie's AUTHORS
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package hegemonie_region_agent
+
+import (
+	"context"
+	"errors"
+	mproto "github.com/jfsmig/hegemonie/pkg/map/proto"
+	"github.com/jfsmig/hegemonie/pkg/region/model"
+	"google.golang.org/grpc"
+	"io"
+	"sync"
+	"time"
+)
+
+// Maximum number of next steps kept in the cache of a MapClient. When the
+// limit is reached, the cache is flushed before being filled again.
+const stepsMax = 1 << 16
+
+type stepKey struct {
+	mapName  string
+	src, dst uint64
+}
+
+// MapClient implements the MapView of the World with the GetPath RPC of
+// the Map service. The maps are immutable, so the next steps of each path
+// are kept in a local cache: since any part of a shortest path is itself a
+// shortest path, one call fills the cache for all the steps of the path.
+// The size of that cache is bounded by stepsMax.
+// The roads of each map are loaded at once, at the first need.
+type MapClient struct {
+	cnx *grpc.ClientConn
+
+	rw    sync.RWMutex
+	steps map[stepKey]uint64
+	roads map[string]map[uint64][]uint64
+}
+
+func NewMapClient(cnx *grpc.ClientConn) *MapClient {
+	return &MapClient{
+		cnx:   cnx,
+		steps: make(map[stepKey]uint64),
+		roads: make(map[string]map[uint64][]uint64),
+	}
+}
+
+func (m *MapClient) Step(mapName string, src, dst uint64) (uint64, error) {
+	k := stepKey{mapName, src, dst}
+	m.rw.RLock()
+	next, ok := m.steps[k]
+	m.rw.RUnlock()
+	if ok {
+		return next, nil
+	}
+
+	path, err := m.getPath(mapName, src, dst)
+	if err != nil {
+		return 0, err
+	}
+
+	m.rw.Lock()
+	defer m.rw.Unlock()
+	if len(m.steps)+len(path) > stepsMax {
+		m.steps = make(map[stepKey]uint64)
+	}
+	for i := 0; i < len(path)-1; i++ {
+		m.steps[stepKey{mapName, path[i], dst}] = path[i+1]
+	}
+	return m.steps[k], nil
+}
+
+func (m *MapClient) Neighbors(mapName string, id uint64) ([]uint64, error) {
+	m.rw.RLock()
+	roads, ok := m.roads[mapName]
+	m.rw.RUnlock()
+	if ok {
+		return roads[id], nil
+	}
+
+	roads, err := m.getRoads(mapName)
+	if err != nil {
+		return nil, err
+	}
+
+	m.rw.Lock()
+	defer m.rw.Unlock()
+	m.roads[mapName] = roads
+	return roads[id], nil
+}
+
+// Return all the roads of the map, indexed by their source
+func (m *MapClient) getRoads(mapName string) (map[uint64][]uint64, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	client := mproto.NewMapClient(m.cnx)
+	rep, err := client.Edges(ctx, &mproto.ListEdgesReq{MapName: mapName})
+	if err != nil {
+		return nil, err
+	}
+
+	out := make(map[uint64][]uint64)
+	for {
+		x, err := rep.Recv()
+		if err == io.EOF {
+			return out, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		out[x.GetSrc()] = append(out[x.GetSrc()], x.GetDst())
+	}
+}
+
+// Return the complete path from src to dst, both included
+func (m *MapClient) getPath(mapName string, src, dst uint64) ([]uint64, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	client := mproto.NewMapClient(m.cnx)
+	rep, err := client.GetPath(ctx, &mproto.PathRequest{MapName: mapName, Src: src, Dst: dst})
+	if err != nil {
+		return nil, err
+	}
+
+	path := []uint64{src}
+	for {
+		x, err := rep.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if x.GetId() != path[len(path)-1] {
+			path = append(path, x.GetId())
+		}
+	}
+	if path[len(path)-1] != dst {
+		path = append(path, dst)
+	}
+	if len(path) < 2 {
+		return nil, errors.New("No route")
+	}
+	return path, nil
+}
+
+// Return the locations of all the Cities on the given map
+func (m *MapClient) Cities(ctx context.Context, mapName string) ([]region.CitySite, error) {
+	client := mproto.NewMapClient(m.cnx)
+	rep, err := client.Cities(ctx, &mproto.ListCitiesReq{MapName: mapName})
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]region.CitySite, 0)
+	for {
+		x, err := rep.Recv()
+		if err == io.EOF {
+			return out, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, region.CitySite{ID: x.GetId(), Name: x.GetName()})
+	}
+}
@@ -0,0 +1,114 @@
+// Copyright (C) 2018-2020 Hegemonie's AUTHORS
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package hegemonie_region_agent
+
+import (
+	"context"
+	"github.com/jfsmig/hegemonie/pkg/region/model"
+	"github.com/jfsmig/hegemonie/pkg/utils"
+	"sync"
+	"time"
+)
+
+// regionSaver persists the Regions of the World under the backend path.
+// The Regions are serialised while the World is locked for writing, then
+// written on disk once the World is unlocked, in the order they have been
+// serialised.
+type regionSaver struct {
+	backend string
+	mu      sync.Mutex
+}
+
+// Run the action with the World locked for writing, then save the Regions
+// returned by the action.
+func (s *regionSaver) update(w *region.World, action func() ([]*region.Region, error)) error {
+	w.WLock()
+	snapshots, err := s.snapshot(w, action)
+	if err != nil {
+		w.WUnlock()
+		return err
+	}
+
+	// Locked before the World is released, so that a concurrent update
+	// cannot write an older state after the current one.
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	w.WUnlock()
+
+	for _, snap := range snapshots {
+		if err = snap.Write(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *regionSaver) snapshot(w *region.World, action func() ([]*region.Region, error)) ([]*region.RegionSnapshot, error) {
+	regions, err := action()
+	if err != nil {
+		return nil, err
+	}
+	out := make([]*region.RegionSnapshot, 0, len(regions))
+	for _, r := range regions {
+		snap, err := w.SnapshotRegion(s.backend, r)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, snap)
+	}
+	return out, nil
+}
+
+// Periodically play the rounds that are due in each Region, according to its
+// Schedule, and periodically save all the Regions, until the context is
+// cancelled. Each Region that played a round is saved at once, so that its
+// tick counters survive a restart. The periodic save persists the actions of
+// the players, even in the Regions whose Schedule is paused.
+// A zero period disables the corresponding activity.
+func runScheduler(ctx context.Context, w *region.World, saver *regionSaver, tick, save time.Duration) {
+	var tickC, saveC <-chan time.Time
+	if tick > 0 {
+		ticker := time.NewTicker(tick)
+		defer ticker.Stop()
+		tickC = ticker.C
+	}
+	if save > 0 {
+		ticker := time.NewTicker(save)
+		defer ticker.Stop()
+		saveC = ticker.C
+	}
+
+	for {
+		var err error
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-tickC:
+			err = saver.update(w, func() ([]*region.Region, error) {
+				return tickRegions(w, now), nil
+			})
+		case <-saveC:
+			err = saver.update(w, func() ([]*region.Region, error) {
+				return append([]*region.Region{}, w.Regions...), nil
+			})
+		}
+		if err != nil {
+			utils.Logger.Error().Err(err).Msg("save")
+		}
+	}
+}
+
+// Play the rounds that are due and return the Regions that played any.
+// The World must be locked for writing by the caller.
+func tickRegions(w *region.World, now time.Time) []*region.Region {
+	out := make([]*region.Region, 0)
+	for _, r := range w.Regions {
+		if r.Tick(now) {
+			out = append(out, r)
+		}
+	}
+	return out
+}
@@ -6,6 +6,7 @@
 package hegemonie_region_agent
 
 import (
+	"encoding/json"
 	"github.com/jfsmig/hegemonie/pkg/region/model"
 	proto "github.com/jfsmig/hegemonie/pkg/region/proto"
 )
@@ -73,6 +74,9 @@ func resAbsM2P(r region.Resources) *proto.ResourcesAbs {
 
 func resAbsP2M(rm *proto.ResourcesAbs) region.Resources {
 	r := region.Resources{}
+	if rm == nil {
+		return r
+	}
 	r[0] = rm.R0
 	r[1] = rm.R1
 	r[2] = rm.R2
@@ -82,6 +86,25 @@ func resAbsP2M(rm *proto.ResourcesAbs) region.Resources {
 	return r
 }
 
+func moveArgsM2P(args region.ActionArgMove) *proto.ArmyMoveArgs {
+	return &proto.ArmyMoveArgs{
+		Stock:     resAbsM2P(args.Amount),
+		Units:     args.Units,
+		Artifacts: args.Artifacts,
+	}
+}
+
+func moveArgsP2M(args *proto.ArmyMoveArgs) region.ActionArgMove {
+	if args == nil {
+		return region.ActionArgMove{}
+	}
+	return region.ActionArgMove{
+		Amount:    resAbsP2M(args.Stock),
+		Units:     args.Units,
+		Artifacts: args.Artifacts,
+	}
+}
+
 // M2P -> Model to Proto
 func resModM2P(r region.ResourceModifiers) *proto.ResourcesMod {
 	rm := proto.ResourcesMod{}
@@ -96,7 +119,10 @@ func ShowProduction(w *region.World, c *region.City) *proto.ProductionView {
 	v.Base = resAbsM2P(prod.Base)
 	v.Buildings = resModM2P(prod.Buildings)
 	v.Knowledge = resModM2P(prod.Knowledge)
+	v.Artifacts = resModM2P(prod.Artifacts)
+	v.Troops = resModM2P(prod.Troops)
 	v.Actual = resAbsM2P(prod.Actual)
+	v.Shortage = resAbsM2P(prod.Shortage)
 	return v
 }
 
@@ -106,6 +132,9 @@ func ShowStock(w *region.World, c *region.City) *proto.StockView {
 	v.Base = resAbsM2P(stock.Base)
 	v.Buildings = resModM2P(stock.Buildings)
 	v.Knowledge = resModM2P(stock.Knowledge)
+	v.Artifacts = resModM2P(stock.Artifacts)
+	// The Units have no impact on the storage capacity
+	v.Troops = resModM2P(region.ResourceModifierNoop())
 	v.Actual = resAbsM2P(stock.Actual)
 	v.Usage = resAbsM2P(stock.Usage)
 	return v
@@ -125,9 +154,7 @@ func ShowAssets(w *region.World, c *region.City) *proto.CityAssets {
 		})
 	}
 	for _, u := range c.Units {
-		v.Units = append(v.Units, &proto.UnitView{
-			Id: u.ID, IdType: u.Type, Ticks: uint32(u.Ticks), Health: u.Health,
-		})
+		v.Units = append(v.Units, ShowUnit(w, u))
 	}
 
 	for _, a := range c.Armies {
@@ -136,6 +163,9 @@ func ShowAssets(w *region.World, c *region.City) *proto.CityAssets {
 			Stock: resAbsM2P(a.Stock),
 		})
 	}
+	for _, a := range c.Artifacts {
+		v.Artifacts = append(v.Artifacts, ShowArtifact(a))
+	}
 
 	return v
 }
@@ -157,7 +187,10 @@ func ShowCity(w *region.World, c *region.City) *proto.CityView {
 		Deputy: c.Deputy,
 
 		TickMassacres: c.TicksMassacres,
+		TickEpidemic:  c.TicksEpidemic,
+		Health:        c.GetActualHealth(w),
 		Auto:          c.Auto,
+		Queue:         ShowQueue(&c.Queue),
 
 		Politics: &proto.CityPolitics{
 			Overlord: c.Overlord,
@@ -182,6 +215,10 @@ func ShowArmyCommand(c *region.Command) *proto.ArmyCommand {
 	case region.CmdMove:
 		cmd.Type = proto.ArmyCommandType_Move
 		cmd.Move = &proto.ArmyMoveArgs{}
+		var args region.ActionArgMove
+		if c.Args != "" && json.Unmarshal([]byte(c.Args), &args) == nil {
+			cmd.Move = moveArgsM2P(args)
+		}
 	case region.CmdWait:
 		cmd.Type = proto.ArmyCommandType_Wait
 	case region.CmdCityAttack:
@@ -197,28 +234,65 @@ func ShowArmyCommand(c *region.Command) *proto.ArmyCommand {
 
 func ShowArmy(w *region.World, a *region.Army) *proto.ArmyView {
 	view := &proto.ArmyView{
-		Id:       a.ID,
-		Name:     a.Name,
-		Location: a.Cell,
-		Stock:    resAbsM2P(a.Stock),
+		Id:           a.ID,
+		Name:         a.Name,
+		Location:     a.Cell,
+		Stock:        resAbsM2P(a.Stock),
+		TickEpidemic: a.TicksEpidemic,
 	}
 	for _, u := range a.Units {
 		view.Units = append(view.Units, ShowUnit(w, u))
 	}
+	for _, art := range a.Artifacts {
+		view.Artifacts = append(view.Artifacts, ShowArtifact(art))
+	}
 	for _, c := range a.Targets {
 		view.Commands = append(view.Commands, ShowArmyCommand(&c))
 	}
+	for _, p := range a.Postures {
+		if p > 0 {
+			view.Postures = append(view.Postures, &proto.ArmyPosture{City: uint64(p), Value: 1})
+		} else if p < 0 {
+			view.Postures = append(view.Postures, &proto.ArmyPosture{City: uint64(-p), Value: -1})
+		}
+	}
+	for _, p := range a.PosturesCharacter {
+		view.Postures = append(view.Postures, &proto.ArmyPosture{Character: p.Character, Value: p.Value})
+	}
 	return view
 }
 
+func ShowUnitType(ut *region.UnitType) *proto.UnitTypeView {
+	v := &proto.UnitTypeView{
+		Id:           ut.ID,
+		Name:         ut.Name,
+		Ticks:        ut.Ticks,
+		Health:       ut.Health,
+		HealthFactor: ut.HealthFactor,
+		Attack:       ut.Attack,
+		Defense:      ut.Defense,
+		Armor:        ut.Armor,
+		Speed:        ut.Speed,
+		Carry:        ut.Carry,
+	}
+	for _, b := range ut.Bonus {
+		v.Bonus = append(v.Bonus, &proto.UnitTypeBonus{IdType: b.Type, Mult: b.Mult})
+	}
+	return v
+}
+
 func ShowUnit(w *region.World, u *region.Unit) *proto.UnitView {
-	return &proto.UnitView{
+	v := &proto.UnitView{
 		Id:     u.ID,
 		IdType: u.Type,
 		Name:   "",
 		Ticks:  u.Ticks,
 		Health: u.Health,
 	}
+	if ut := w.UnitTypeGet(u.Type); ut != nil {
+		v.HealthMax = ut.Health
+	}
+	return v
 }
 
 func ShowCityPublic(w *region.World, c *region.City, scored bool) *proto.PublicCity {
@@ -226,7 +300,7 @@ func ShowCityPublic(w *region.World, c *region.City, scored bool) *proto.PublicC
 	if scored {
 		score = c.GetActualPopularity(w)
 	}
-	return &proto.PublicCity{
+	v := &proto.PublicCity{
 		Id:        c.ID,
 		Name:      c.Name,
 		Score:     score,
@@ -236,4 +310,122 @@ func ShowCityPublic(w *region.World, c *region.City, scored bool) *proto.PublicC
 		Politics:  c.PoliticalGroup,
 		Ethny:     c.EthnicGroup,
 	}
+	for _, a := range c.VisibleArtifacts() {
+		v.Artifacts = append(v.Artifacts, ShowArtifact(a))
+	}
+	return v
+}
+
+func ShowArtifact(a *region.Artifact) *proto.Artifact {
+	return &proto.Artifact{
+		Id:      a.ID,
+		Type:    a.Type,
+		Name:    a.Name,
+		Visible: a.Visible,
+	}
+}
+
+func ShowQueue(q *region.ProductionQueue) *proto.ProductionQueueView {
+	return &proto.ProductionQueueView{
+		Order:  append([]string{}, q.Order...),
+		Paused: append([]string{}, q.Paused...),
+		Budget: &proto.QueueBudget{
+			Units:      q.Budget[region.QueueUnits],
+			Buildings:  q.Budget[region.QueueBuildings],
+			Knowledges: q.Budget[region.QueueKnowledges],
+		},
+	}
+}
+
+func ShowSchedule(s *region.Schedule) *proto.ScheduleView {
+	return &proto.ScheduleView{
+		MovePeriod:    s.MovePeriod,
+		ProducePeriod: s.ProducePeriod,
+		Paused:        s.Paused,
+		MoveTicks:     s.MoveTicks,
+		ProduceTicks:  s.ProduceTicks,
+		LastMove:      s.LastMove,
+		LastProduce:   s.LastProduce,
+	}
+}
+
+func ShowPopularityChange(pc region.PopularityChange) *proto.PopularityChange {
+	return &proto.PopularityChange{
+		Reason: pc.Reason,
+		IdType: pc.Type,
+		Delta:  pc.Delta,
+	}
+}
+
+func ShowArtifactType(at *region.ArtifactType) *proto.ArtifactTypeView {
+	return &proto.ArtifactTypeView{
+		Id:       at.ID,
+		Name:     at.Name,
+		Visible:  at.Visible,
+		PopBonus: at.PopBonus,
+		Stock:    resModM2P(at.Stock),
+		Prod:     resModM2P(at.Prod),
+	}
+}
+
+func treatyKindM2P(kind string) proto.TreatyKind {
+	switch kind {
+	case region.TreatyAlliance:
+		return proto.TreatyKind_Alliance
+	case region.TreatyNonAggression:
+		return proto.TreatyKind_NonAggression
+	case region.TreatyTrade:
+		return proto.TreatyKind_Trade
+	case region.TreatyWar:
+		return proto.TreatyKind_War
+	default:
+		return proto.TreatyKind_NoTreaty
+	}
+}
+
+func treatyKindP2M(kind proto.TreatyKind) string {
+	switch kind {
+	case proto.TreatyKind_Alliance:
+		return region.TreatyAlliance
+	case proto.TreatyKind_NonAggression:
+		return region.TreatyNonAggression
+	case proto.TreatyKind_Trade:
+		return region.TreatyTrade
+	case proto.TreatyKind_War:
+		return region.TreatyWar
+	default:
+		return ""
+	}
+}
+
+func claimStrategyP2M(strategy proto.ClaimStrategy) string {
+	switch strategy {
+	case proto.ClaimStrategy_ClaimRandom:
+		return region.ClaimRandom
+	case proto.ClaimStrategy_ClaimFarthest:
+		return region.ClaimFarthest
+	case proto.ClaimStrategy_ClaimCell:
+		return region.ClaimCell
+	default:
+		return ""
+	}
+}
+
+func ShowTreaty(t *region.Treaty) *proto.TreatyView {
+	return &proto.TreatyView{
+		Id:       t.ID,
+		Kind:     treatyKindM2P(t.Kind),
+		Proposer: t.Proposer,
+		Target:   t.Target,
+		Active:   t.Active,
+	}
+}
+
+func ShowOffer(o *region.Offer) *proto.OfferView {
+	return &proto.OfferView{
+		Id:    o.ID,
+		City:  o.City,
+		Goods: resAbsM2P(o.Goods),
+		Price: resAbsM2P(o.Price),
+	}
 }
@@ -7,6 +7,8 @@ package hegemonie_region_client
 
 import (
 	"errors"
+	"fmt"
+	region "github.com/jfsmig/hegemonie/pkg/region/model"
 	"github.com/jfsmig/hegemonie/pkg/utils"
 	"github.com/spf13/cobra"
 )
@@ -27,7 +29,40 @@ func Command() *cobra.Command {
 		},
 	}
 
+	check := &cobra.Command{
+		Use:     "check",
+		Short:   "Validate the definitions of a World",
+		Long:    "Check the referential integrity of the definitions (knowledge, buildings, units, artifacts) stored in a directory, and report all the problems.",
+		Args:    cobra.ExactArgs(1),
+		Example: `hege region check ./docs/definitions/hegeIV`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return doCheck(args[0])
+		},
+	}
+
 	cmd.Flags().StringVar(&cfg.endpoint,
 		"endpoint", utils.DefaultEndpointRegion, "IP:PORT endpoint for the TCP/IP server")
+	cmd.AddCommand(check)
 	return cmd
 }
+
+func doCheck(path string) error {
+	defs := region.DefinitionsBase{}
+	if err := defs.Sections(path).Load(); err != nil {
+		return err
+	}
+	if err := defs.PostLoad(); err != nil {
+		return err
+	}
+	if err := defs.Check(); err != nil {
+		var errs region.DefinitionErrors
+		if !errors.As(err, &errs) {
+			return err
+		}
+		for _, e := range errs {
+			fmt.Println(e)
+		}
+		return fmt.Errorf("%d problem(s) found in [%s]", len(errs), path)
+	}
+	return nil
+}
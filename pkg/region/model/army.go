@@ -7,9 +7,7 @@ package region
 
 import (
 	"encoding/json"
-	"errors"
 	"fmt"
-	"github.com/google/uuid"
 	"github.com/jfsmig/hegemonie/pkg/utils"
 	"math/rand"
 	"sort"
@@ -20,8 +18,162 @@ func (a *Army) PopCommand() {
 	a.Targets = a.Targets[1:]
 }
 
+// Return the attitude of the Army toward the given City: a positive value
+// means "defend", a negative value means "assault" and 0 means "neutral".
+// An Army always defends its own City.
+func (a *Army) PostureToward(c *City) int64 {
+	if c == nil {
+		return 0
+	}
+	if c == a.City {
+		return 1
+	}
+	for _, p := range a.Postures {
+		if p == int64(c.ID) {
+			return 1
+		}
+		if p == -int64(c.ID) {
+			return -1
+		}
+	}
+	for _, p := range a.PosturesCharacter {
+		if p.Character == c.Owner {
+			return p.Value
+		}
+	}
+	return 0
+}
+
+// Set the attitude of the Army toward the given City.
+// A positive value means "defend", a negative value means "assault" and 0
+// removes the posture.
+func (a *Army) SetPostureCity(id uint64, v int64) error {
+	if id == 0 {
+		return errInvalidCity
+	}
+	if id == a.City.ID && v < 0 {
+		return errAssaultOwnCity
+	}
+
+	postures := a.Postures[:0]
+	for _, p := range a.Postures {
+		if p != int64(id) && p != -int64(id) {
+			postures = append(postures, p)
+		}
+	}
+	if v > 0 {
+		postures = append(postures, int64(id))
+	} else if v < 0 {
+		postures = append(postures, -int64(id))
+	}
+	a.Postures = postures
+	return nil
+}
+
+// Set the attitude of the Army toward all the cities of the given Character.
+// A positive value means "defend", a negative value means "assault" and 0
+// removes the posture.
+func (a *Army) SetPostureCharacter(id string, v int64) error {
+	if id == "" {
+		return errInvalidCharacter
+	}
+	if id == a.City.Owner && v < 0 {
+		return errAssaultOwnChar
+	}
+
+	postures := a.PosturesCharacter[:0]
+	for _, p := range a.PosturesCharacter {
+		if p.Character != id {
+			postures = append(postures, p)
+		}
+	}
+	if v != 0 {
+		postures = append(postures, CharacterPosture{Character: id, Value: v})
+	}
+	a.PosturesCharacter = postures
+	return nil
+}
+
+// Join the given Fight on the side dictated by the Postures of the Army.
+// The posture toward the local City prevails, then the postures toward the
+// defenders and eventually toward the attackers. Without any posture, the
+// Army defends the allies of its owner.
+// Return true if the Army joined the Fight.
+func (a *Army) joinFightByPosture(w *Region, f *Fight) bool {
+	join := func(attack bool) bool {
+		a.Fight = f.ID
+		if attack {
+			f.Attack.Add(a)
+		} else {
+			f.Defense.Add(a)
+		}
+		return true
+	}
+
+	if pCity := w.CityGetAt(f.Cell); pCity != nil && pCity.Assault == f {
+		if p := a.postureIn(w, pCity); p != 0 {
+			return join(p < 0)
+		}
+	}
+	for _, d := range f.Defense {
+		if p := a.postureIn(w, d.City); p != 0 {
+			return join(p < 0)
+		}
+	}
+	for _, d := range f.Attack {
+		if p := a.postureIn(w, d.City); p != 0 {
+			return join(p > 0)
+		}
+	}
+
+	allied := func(c *City) bool {
+		return c != nil && a.City != nil && w.TreatyActive(a.City.Owner, c.Owner, TreatyAlliance)
+	}
+	if pCity := w.CityGetAt(f.Cell); pCity != nil && pCity.Assault == f && allied(pCity) {
+		return join(false)
+	}
+	for _, d := range f.Defense {
+		if allied(d.City) {
+			return join(false)
+		}
+	}
+	return false
+}
+
+// Make the Army react to the presence of other armies or cities at its
+// location, according to its Postures:
+// the Army first joins the Fights already happening at its location,
+// then it assaults the local City if it is hostile, then it assaults the
+// first hostile Army met.
 func (a *Army) ApplyAgressivity(w *Region) {
-	// FIXME(jfs): NYI
+	if a.Fight != "" {
+		return
+	}
+
+	for _, f := range w.Fights.SliceByCell(a.Cell) {
+		if a.joinFightByPosture(w, f) {
+			return
+		}
+	}
+
+	if pCity := w.CityGetAt(a.Cell); pCity != nil && a.postureIn(w, pCity) < 0 {
+		a.JoinCityAttack(w, pCity)
+		return
+	}
+
+	for _, other := range w.ArmiesAt(a.Cell) {
+		if other.Fight != "" || other.City == a.City {
+			continue
+		}
+		if a.postureIn(w, other.City) < 0 {
+			f := w.fightCreate(a.Cell)
+			a.Fight = f.ID
+			f.Attack.Add(a)
+			other.Fight = f.ID
+			f.Defense.Add(other)
+			return
+		}
+	}
 }
 
 func (a *Army) Move(r *Region) {
@@ -39,8 +191,11 @@ func (a *Army) Move(r *Region) {
 		dst := cmd.Cell
 
 		pLocalCity := r.CityGetAt(a.Cell)
+		if pLocalCity != nil {
+			a.epidemicContact(r, pLocalCity)
+		}
 
-		nxt, err := w.mapView.Step(src, dst)
+		nxt, err := r.Step(src, dst)
 		if err != nil || nxt == 0 {
 			if err != nil {
 				utils.Logger.Warn().Err(err).Uint64("src", src).Uint64("dst", dst).Send()
@@ -48,6 +203,9 @@ func (a *Army) Move(r *Region) {
 			w.notifier.Army(a.City).Item(a).NoRoute(src, dst).Send()
 		} else {
 			a.Cell = nxt
+			if pNext := r.CityGetAt(nxt); pNext != nil {
+				a.epidemicContact(r, pNext)
+			}
 			w.notifier.Army(a.City).Item(a).Move(src, dst).Send()
 			if pLocalCity != nil && a.City.ID != pLocalCity.ID {
 				w.notifier.Army(pLocalCity).Item(a).Move(src, dst).Send()
@@ -55,18 +213,37 @@ func (a *Army) Move(r *Region) {
 		}
 
 		if nxt == dst {
+			// The actions apply to the City at the destination
+			pLocalCity = r.CityGetAt(dst)
 			var preventPopping bool
 			switch cmd.Action {
 			case CmdMove:
-				// Just a stop on the way
+				// Just a stop on the way, with an optional delivery
+				if pLocalCity != nil && cmd.Args != "" {
+					var args ActionArgMove
+					if err := json.Unmarshal([]byte(cmd.Args), &args); err != nil {
+						utils.Logger.Warn().Err(err).Str("army", a.ID).Msg("move args")
+					} else {
+						a.Deliver(r, pLocalCity, args)
+					}
+				}
 			case CmdCityAttack:
-				a.JoinCityAttack(r, pLocalCity)
+				// The command is consumed at the end of the Fight, when the
+				// post-victory actions are applied.
+				if a.JoinCityAttack(r, pLocalCity) {
+					preventPopping = true
+				}
 			case CmdCityDefend:
 				if a.JoinCityDefence(r, pLocalCity) {
 					preventPopping = true
 				}
 			case CmdCityDisband:
-				a.Disband(r, pLocalCity, true)
+				if pLocalCity != nil {
+					a.Disband(r, pLocalCity, true)
+					a.Deposit(r, pLocalCity)
+				}
+				a.City.Armies.Remove(a)
+				return
 			}
 			if !preventPopping {
 				a.PopCommand()
@@ -76,6 +253,79 @@ func (a *Army) Move(r *Region) {
 	a.ApplyAgressivity(r)
 }
 
+// Decode the arguments of a move, including the arguments persisted before
+// the Units and the Artifacts were identified by strings, with numeric "units"
+// and a single numeric "artifact".
+func (args *ActionArgMove) UnmarshalJSON(b []byte) error {
+	var raw struct {
+		Amount    Resources         `json:"amount,omitempty"`
+		Artifact  json.Number       `json:"artifact,omitempty"`
+		Artifacts []string          `json:"artifacts,omitempty"`
+		Units     []json.RawMessage `json:"units,omitempty"`
+	}
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return err
+	}
+
+	args.Amount = raw.Amount
+	args.Artifacts = raw.Artifacts
+	if raw.Artifact != "" && raw.Artifact != "0" {
+		args.Artifacts = append(args.Artifacts, raw.Artifact.String())
+	}
+	args.Units = nil
+	for _, u := range raw.Units {
+		var id string
+		if err := json.Unmarshal(u, &id); err != nil {
+			var n json.Number
+			if err = json.Unmarshal(u, &n); err != nil {
+				return err
+			}
+			id = n.String()
+		}
+		args.Units = append(args.Units, id)
+	}
+	return nil
+}
+
+// Transfer to the given City the part of the content of the Army that is
+// described in the arguments: the Resources (in the limit of the stock of
+// the Army), the Units and the Artifacts. Both the City that controls the
+// Army and the receiving City are notified.
+func (a *Army) Deliver(w *Region, pCity *City, args ActionArgMove) {
+	if pCity == nil {
+		panic("Impossible action: nil city")
+	}
+
+	amount := args.Amount
+	amount.TrimTo(a.Stock)
+	a.Stock.Remove(amount)
+	pCity.Stock.Add(amount)
+
+	nbUnits := 0
+	for _, id := range args.Units {
+		if u := a.Units.Get(id); u != nil {
+			a.Units.Remove(u)
+			pCity.Units.Add(u)
+			nbUnits++
+		}
+	}
+
+	nbArtifacts := 0
+	for _, id := range args.Artifacts {
+		if art := a.Artifacts.Get(id); art != nil {
+			a.Artifacts.Remove(art)
+			pCity.Artifacts.Add(art)
+			nbArtifacts++
+		}
+	}
+
+	n := w.world.notifier
+	n.Army(a.City).Item(a).Deliver(pCity.ID, amount, nbUnits, nbArtifacts).Send()
+	if pCity != a.City {
+		n.Army(pCity).Item(a).Deliver(pCity.ID, amount, nbUnits, nbArtifacts).Send()
+	}
+}
+
 func (a *Army) Deposit(w *Region, pCity *City) {
 	if pCity == nil {
 		panic("Impossible action: nil city")
@@ -126,10 +376,17 @@ func (a *Army) BreakBuilding(w *Region, pCity *City) {
 		panic("Impossible action: nil city")
 	}
 
+	if len(pCity.Buildings) <= 0 {
+		return
+	}
 	idx := rand.Intn(len(pCity.Buildings))
-	pCity.Buildings.Remove(pCity.Buildings[idx])
+	b := pCity.Buildings[idx]
+	pCity.Buildings.Remove(b)
 
-	// FIXME(jfs): Popularities
+	if bt := w.world.BuildingTypeGet(b.Type); bt != nil {
+		pCity.ChangePopularity(PopReasonFall, bt.ID, bt.PopBonusFall)
+		a.City.ChangePopularity(PopReasonDestroy, bt.ID, bt.PopBonusDestroy)
+	}
 	// FIXME(jfs): Notify pLocalCity
 	// FIXME(jfs): Notify a.City
 }
@@ -142,7 +399,7 @@ func (a *Army) Conquer(w *World, pCity *City) {
 }
 
 func (a *Army) JoinCityDefence(w *Region, pCity *City) bool {
-	if pCity.Assault == nil {
+	if pCity == nil || pCity.Assault == nil {
 		return false
 	}
 	if pCity.Assault.Cell != a.Cell {
@@ -158,12 +415,17 @@ func (a *Army) JoinCityDefence(w *Region, pCity *City) bool {
 	return true
 }
 
-func (a *Army) JoinCityAttack(w *Region, pCity *City) {
+// Start or join the assault of the City. A pact between the owners, maybe
+// signed since the command was issued, prevents the assault.
+func (a *Army) JoinCityAttack(w *Region, pCity *City) bool {
+	if pCity == nil {
+		return false
+	}
+	if a.City != nil && w.Peaceful(a.City.Owner, pCity.Owner) {
+		return false
+	}
 	if pCity.Assault == nil {
-		pCity.Assault = &Fight{
-			ID:      uuid.New().String(),
-			Defense: make(SetOfArmies, 0),
-			Attack:  make(SetOfArmies, 0)}
+		pCity.Assault = w.fightCreate(pCity.ID)
 		if def, _ := pCity.CreateArmyDefence(w); def != nil {
 			def.Fight = pCity.Assault.ID
 			pCity.Assault.Defense.Add(def)
@@ -179,25 +441,128 @@ func (a *Army) JoinCityAttack(w *Region, pCity *City) {
 
 	a.Fight = pCity.Assault.ID
 	pCity.Assault.Attack.Add(a)
+	return true
+}
+
+// Remove the Army from the Fight it is involved in, on any side.
+// Return the side the Army has been removed from.
+func (a *Army) leaveFight(f *Fight) *SetOfArmies {
+	a.Fight = ""
+	if f.Attack.Has(a.ID) {
+		f.Attack.Remove(a)
+		return &f.Attack
+	}
+	if f.Defense.Has(a.ID) {
+		f.Defense.Remove(a)
+		return &f.Defense
+	}
+	return nil
+}
+
+// Drop the pending command that led the Army into the Fight, if any.
+func (a *Army) dropFightCommand(f *Fight) {
+	if len(a.Targets) > 0 && a.Targets[0].Cell == f.Cell {
+		switch a.Targets[0].Action {
+		case CmdCityAttack, CmdCityDefend:
+			a.PopCommand()
+		}
+	}
+}
+
+func (a *Army) notifyFight(w *Region, f *Fight, evt func(EventArmy) EventArmy) {
+	n := w.world.notifier
+	evt(n.Army(a.City).Item(a)).Send()
+	if pCity := w.CityGetAt(f.Cell); pCity != nil && pCity != a.City {
+		evt(n.Army(pCity).Item(a)).Send()
+	}
 }
 
 // Leave the Fight as a loser
+// Each Unit of the Army loses a ratio of its Health, as configured in the
+// World. Then the Army is back on the Map and resumes its command queue.
 func (a *Army) Flea(w *Region) error {
-	return errors.New("Flea NYI")
+	f := w.Fights.Get(a.Fight)
+	if f == nil {
+		return errNotInFight
+	}
+	if a.leaveFight(f) == nil {
+		return errNotInFight
+	}
+	a.dropFightCommand(f)
+
+	penalty := w.world.Config.FleaPenalty
+	for _, u := range a.Units {
+		loss := uint32(float64(u.Health) * penalty)
+		if loss >= u.Health {
+			u.Health = 0
+		} else {
+			u.Health -= loss
+		}
+	}
+	a.City.buryDead(w.world, &a.Units)
+
+	a.notifyFight(w, f, func(evt EventArmy) EventArmy { return evt.Flea(f.Cell) })
+	return nil
 }
 
 // Change the side in the Fight.
 // If the Army was defending, it becomes an attacker, if it was an attacker
 // it becomes a defender.
 func (a *Army) Flip(w *Region) error {
-	return errNotImplemented
+	f := w.Fights.Get(a.Fight)
+	if f == nil {
+		return errNotInFight
+	}
+	// Like SetPostureCity, never let an Army assault its own City
+	if f.Cell == a.City.ID && f.Defense.Has(a.ID) {
+		return errAssaultOwnCity
+	}
+	side := a.leaveFight(f)
+	if side == nil {
+		return errNotInFight
+	}
+	a.dropFightCommand(f)
+
+	a.Fight = f.ID
+	if side == &f.Attack {
+		f.Defense.Add(a)
+	} else {
+		f.Attack.Add(a)
+	}
+	a.City.ChangePopularity(PopReasonArmyFlip, 0, w.world.Config.PopBonusArmyFlip)
+
+	a.notifyFight(w, f, func(evt EventArmy) EventArmy { return evt.Flip(f.Cell) })
+	return nil
 }
 
+// Destroy the Army and return all its content to its City.
+// The Army must stand on the location of its City and must not be involved
+// in any Fight.
 func (a *Army) Cancel(w *Region) error {
-	return errNotImplemented
+	pCity := a.City
+	if a.Fight != "" {
+		return errArmyInFight
+	}
+	if a.Cell != pCity.ID {
+		return errArmyNotHome
+	}
+
+	a.Disband(w, pCity, false)
+	a.Deposit(w, pCity)
+	for _, art := range a.Artifacts {
+		pCity.Artifacts.Add(art)
+	}
+	a.Artifacts = a.Artifacts[:0]
+
+	pCity.ChangePopularity(PopReasonArmyDisband, 0, w.world.Config.PopBonusArmyDisband)
+	pCity.Armies.Remove(a)
+	return nil
 }
 
 func (a *Army) DeferAttack(w *Region, loc uint64, args ActionArgAssault) error {
+	if pCity := w.CityGetAt(loc); pCity != nil && w.Peaceful(a.City.Owner, pCity.Owner) {
+		return errTreatyInForce
+	}
 	var sb strings.Builder
 	err := json.NewEncoder(&sb).Encode(&args)
 	if err != nil {
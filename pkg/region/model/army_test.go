@@ -5,7 +5,197 @@
 
 package region
 
-import "testing"
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
 
-func TestSetOfBuildingType(t *testing.T) {
+func TestArmy_Cancel(t *testing.T) {
+	w := World{}
+	w.Init()
+	w.Config.PopBonusArmyDisband = 2
+
+	r, _ := w.CreateRegion("test", "test")
+	c, _ := r.CityCreate(1)
+	c.Units.Add(&Unit{ID: "u0", Type: 1, Health: 1})
+	c.Stock.Set(ResourcesUniform(10))
+
+	a, err := c.CreateArmyFromIds(r, "u0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = c.TransferOwnResources(a, ResourcesUniform(4)); err != nil {
+		t.Fatal(err)
+	}
+	a.Artifacts.Add(&Artifact{ID: "x"})
+
+	a.Cell = 2
+	if err = a.Cancel(r); err != errArmyNotHome {
+		t.Fatal()
+	}
+	a.Cell = c.ID
+	if err = a.Cancel(r); err != nil {
+		t.Fatal(err)
+	}
+	if len(c.Armies) != 0 || len(c.Units) != 1 || len(c.Artifacts) != 1 {
+		t.Fatal()
+	}
+	if !c.Stock.Equals(ResourcesUniform(10)) || c.PermanentPopularity != 2 {
+		t.Fatal()
+	}
+}
+
+func TestArmy_Postures(t *testing.T) {
+	w := World{}
+	w.Init()
+
+	r, _ := w.CreateRegion("test", "test")
+	c0, _ := r.CityCreate(1)
+	c0.Owner = "a"
+	c1, _ := r.CityCreate(2)
+	c1.Owner = "b"
+
+	a0 := c0.CreateEmptyArmy(r)
+	if a0.PostureToward(c0) <= 0 || a0.PostureToward(c1) != 0 {
+		t.Fatal()
+	}
+	if err := a0.SetPostureCity(c0.ID, -1); err != errAssaultOwnCity {
+		t.Fatal(err)
+	}
+	if err := a0.SetPostureCity(0, 1); err != errInvalidCity {
+		t.Fatal(err)
+	}
+	if err := a0.SetPostureCharacter("a", -1); err != errAssaultOwnChar {
+		t.Fatal(err)
+	}
+	if err := a0.SetPostureCharacter("", 1); err != errInvalidCharacter {
+		t.Fatal(err)
+	}
+	a0.SetPostureCharacter("b", 1)
+	if a0.PostureToward(c1) <= 0 {
+		t.Fatal()
+	}
+	a0.SetPostureCity(c1.ID, -1)
+	if a0.PostureToward(c1) >= 0 {
+		t.Fatal()
+	}
+	a0.SetPostureCity(c1.ID, 0)
+	if a0.PostureToward(c1) <= 0 {
+		t.Fatal()
+	}
+
+	// Two armies meet on a cell without City
+	a1 := c1.CreateEmptyArmy(r)
+	a0.Cell, a1.Cell = 3, 3
+	a0.ApplyAgressivity(r)
+	if len(r.Fights) != 0 {
+		t.Fatal()
+	}
+	a0.SetPostureCharacter("b", -1)
+	a0.ApplyAgressivity(r)
+	if len(r.Fights) != 1 || a0.Fight == "" || a1.Fight != a0.Fight {
+		t.Fatal()
+	}
+	f := r.Fights[0]
+	if !f.Attack.Has(a0.ID) || !f.Defense.Has(a1.ID) {
+		t.Fatal()
+	}
+
+	// A third army joins the defenders of its own City
+	a2 := c1.CreateEmptyArmy(r)
+	a2.Cell = 3
+	a2.ApplyAgressivity(r)
+	if !f.Defense.Has(a2.ID) {
+		t.Fatal()
+	}
+}
+
+func TestArmy_Deliver(t *testing.T) {
+	w := World{}
+	w.Init()
+	w.mapView = &stepMapView{}
+
+	r, _ := w.CreateRegion("test", "test")
+	c0, _ := r.CityCreate(1)
+	c1, _ := r.CityCreate(2)
+	c0.Stock.Set(ResourcesUniform(10))
+	c0.Units.Add(&Unit{ID: "u0", Type: 1, Health: 1})
+	c0.Units.Add(&Unit{ID: "u1", Type: 1, Health: 1})
+
+	a, _ := c0.CreateArmyFromIds(r, "u0", "u1")
+	c0.TransferOwnResources(a, ResourcesUniform(6))
+	a.Artifacts.Add(&Artifact{ID: "x"})
+	err := a.DeferMove(r, c1.ID, ActionArgMove{
+		Amount:    ResourcesUniform(4),
+		Units:     []string{"u1"},
+		Artifacts: []string{"x"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	a.Move(r)
+	if a.Cell != c1.ID || len(a.Targets) != 0 {
+		t.Fatal()
+	}
+	if !c1.Stock.Equals(ResourcesUniform(4)) || !a.Stock.Equals(ResourcesUniform(2)) {
+		t.Fatal()
+	}
+	if len(c1.Units) != 1 || c1.Units[0].ID != "u1" || len(a.Units) != 1 {
+		t.Fatal()
+	}
+	if len(c1.Artifacts) != 1 || len(a.Artifacts) != 0 {
+		t.Fatal()
+	}
+}
+
+// A map that finds no route from a cell to itself
+type strictMapView struct{ stepMapView }
+
+func (m *strictMapView) Step(mapName string, src, dst uint64) (uint64, error) {
+	if src == dst {
+		return 0, errors.New("No route")
+	}
+	return dst, nil
+}
+
+func TestArmy_MoveInPlace(t *testing.T) {
+	w := World{}
+	w.Init()
+	w.mapView = &strictMapView{}
+
+	r, _ := w.CreateRegion("test", "test")
+	c0, _ := r.CityCreate(1)
+	c0.Units.Add(&Unit{ID: "u0", Type: 1, Health: 1})
+	a, _ := c0.CreateArmyFromIds(r, "u0")
+	if err := a.DeferMove(r, c0.ID, ActionArgMove{}); err != nil {
+		t.Fatal(err)
+	}
+
+	// The Army is already at its destination: the command is done
+	a.Move(r)
+	if a.Cell != c0.ID || len(a.Targets) != 0 {
+		t.Fatal()
+	}
+}
+
+func TestActionArgMove_Legacy(t *testing.T) {
+	var args ActionArgMove
+	if err := json.Unmarshal([]byte(`{"artifact":7,"units":[1,2]}`), &args); err != nil {
+		t.Fatal(err)
+	}
+	if len(args.Artifacts) != 1 || args.Artifacts[0] != "7" || len(args.Units) != 2 || args.Units[1] != "2" {
+		t.Fatal(args)
+	}
+
+	in := ActionArgMove{Amount: ResourcesUniform(1), Artifacts: []string{"a"}, Units: []string{"u"}}
+	b, _ := json.Marshal(in)
+	args = ActionArgMove{}
+	if err := json.Unmarshal(b, &args); err != nil {
+		t.Fatal(err)
+	}
+	if !args.Amount.Equals(in.Amount) || args.Artifacts[0] != "a" || args.Units[0] != "u" {
+		t.Fatal(args)
+	}
 }
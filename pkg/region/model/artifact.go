@@ -0,0 +1,67 @@
+// Copyright (C) 2018-2020 Hegemonie's AUTHORS
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package region
+
+import (
+	"errors"
+	"github.com/google/uuid"
+)
+
+// Create an Artifact of the given ArtifactType and place it in the current City
+func (c *City) ArtifactCreate(w *Region, typeID uint64, name string) (*Artifact, error) {
+	pType := w.world.ArtifactTypeGet(typeID)
+	if pType == nil {
+		return nil, errors.New("Artifact Type not found")
+	}
+	if name == "" {
+		name = pType.Name
+	}
+
+	a := &Artifact{
+		ID:      uuid.New().String(),
+		Type:    pType.ID,
+		Name:    name,
+		Visible: pType.Visible,
+	}
+	c.Artifacts.Add(a)
+	return a, nil
+}
+
+// Return the Artifacts of the City that are visible to the other players
+func (c *City) VisibleArtifacts() []*Artifact {
+	out := make([]*Artifact, 0)
+	for _, a := range c.Artifacts {
+		if a.Visible {
+			out = append(out, a)
+		}
+	}
+	return out
+}
+
+// Drop the given Artifact carried by the Army in the City at the location
+// of the Army, whatever the City controlling the Army.
+func (a *Army) DropArtifact(w *Region, id string) error {
+	pCity := w.CityGetAt(a.Cell)
+	if pCity == nil {
+		return errCityNotFound
+	}
+	art := a.Artifacts.Get(id)
+	if art == nil {
+		return ErrNoSuchArtifact
+	}
+
+	a.Artifacts.Remove(art)
+	pCity.Artifacts.Add(art)
+	return nil
+}
+
+// Move all the Artifacts placed in the City to the Army.
+func (a *Army) CaptureArtifacts(w *Region, pCity *City) {
+	for _, art := range pCity.Artifacts {
+		a.Artifacts.Add(art)
+	}
+	pCity.Artifacts = pCity.Artifacts[:0]
+}
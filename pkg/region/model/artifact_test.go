@@ -0,0 +1,64 @@
+// Copyright (C) 2018-2020 Hegemonie's AUTHORS
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package region
+
+import "testing"
+
+func TestArtifact_Lifecycle(t *testing.T) {
+	w := World{}
+	w.Init()
+	w.Definitions.Artifacts.Add(&ArtifactType{
+		ID: 1, Name: "crown", Visible: true, PopBonus: 5,
+		Stock: ResourceModifierNoop(),
+		Prod:  ResourceModifierUniform(2.0, 0),
+	})
+
+	r, _ := w.CreateRegion("test", "test")
+	c0, _ := r.CityCreate(1)
+	c0.Production.Set(ResourcesUniform(10))
+	c1, _ := r.CityCreate(2)
+
+	if _, err := c0.ArtifactCreate(r, 2, ""); err == nil {
+		t.Fatal()
+	}
+	art, err := c0.ArtifactCreate(r, 1, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if art.Name != "crown" || len(c0.VisibleArtifacts()) != 1 {
+		t.Fatal()
+	}
+	if c0.GetActualPopularity(&w) != 5 {
+		t.Fatal()
+	}
+	if !c0.GetProduction(&w).Actual.Equals(ResourcesUniform(20)) {
+		t.Fatal()
+	}
+
+	a := c0.CreateEmptyArmy(r)
+	if err = c0.TransferOwnArtifact(a, "nope"); err != ErrNoSuchArtifact {
+		t.Fatal()
+	}
+	if err = c0.TransferOwnArtifact(a, art.ID); err != nil {
+		t.Fatal(err)
+	}
+	if len(c0.Artifacts) != 0 || len(a.Artifacts) != 1 {
+		t.Fatal()
+	}
+
+	a.Cell = c1.ID
+	if err = a.DropArtifact(r, art.ID); err != nil {
+		t.Fatal(err)
+	}
+	if len(c1.Artifacts) != 1 || len(a.Artifacts) != 0 {
+		t.Fatal()
+	}
+
+	a.CaptureArtifacts(r, c1)
+	if len(c1.Artifacts) != 0 || len(a.Artifacts) != 1 {
+		t.Fatal()
+	}
+}
@@ -48,3 +48,27 @@ func (s SetOfBuildingTypes) Frontier(pop int64, built []*Building, owned []*Know
 	}
 	return result
 }
+
+// Dismantle a Building of the City. A part of the resources actually paid for
+// its construction is given back and the City gets the popularity bonus of the BuildingType. Since the
+// Building doesn't contribute anymore to the storage capacity, the Stock is
+// trimmed to the new capacity.
+func (c *City) Dismantle(w *Region, id string) error {
+	b := c.Buildings.Get(id)
+	if b == nil {
+		return ErrNoSuchBuilding
+	}
+	if b.Ticks > 0 {
+		return errUnderConstruction
+	}
+	bt := w.world.BuildingTypeGet(b.Type)
+	if bt == nil {
+		return errNoBuildingType
+	}
+
+	c.Buildings.Remove(b)
+	c.Stock.Add(b.Paid.GetRatio(bt.DismantleRefund))
+	c.ChangePopularity(PopReasonDismantle, bt.ID, bt.PopBonusDismantle)
+	c.Stock.TrimTo(c.GetStock(w.world).Actual)
+	return nil
+}
@@ -0,0 +1,81 @@
+// Copyright (C) 2018-2020 Hegemonie's AUTHORS
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package region
+
+// Return the part of the resources actually spent on an item in progress
+// that is given back when it is cancelled.
+func cancelRefund(w *World, paid Resources) Resources {
+	return paid.GetRatio(w.Config.CancelRefund)
+}
+
+// Give the refund of a cancelled item back to the City and notify its managers
+func (c *City) cancelled(w *Region, id string, refund Resources) {
+	c.Stock.Add(refund)
+	c.Stock.TrimTo(c.GetStock(w.world).Actual)
+	for _, evt := range c.notifyManagers(w) {
+		evt.Cancel(id, refund).Send()
+	}
+}
+
+// Cancel the construction of a Building. A part of the resources already
+// spent is given back, as configured in the World.
+func (c *City) CancelBuilding(w *Region, id string) error {
+	b := c.Buildings.Get(id)
+	if b == nil {
+		return ErrNoSuchBuilding
+	}
+	if b.Ticks <= 0 {
+		return errNotInProgress
+	}
+	bt := w.world.BuildingTypeGet(b.Type)
+	if bt == nil {
+		return errNoBuildingType
+	}
+
+	c.Buildings.Remove(b)
+	c.cancelled(w, id, cancelRefund(w.world, b.Paid))
+	return nil
+}
+
+// Cancel the study of a Knowledge. A part of the resources already spent is
+// given back, as configured in the World.
+func (c *City) CancelKnowledge(w *Region, id string) error {
+	k := c.Knowledges.Get(id)
+	if k == nil {
+		return ErrNoSuchKnowledge
+	}
+	if k.Ticks <= 0 {
+		return errNotInProgress
+	}
+	kt := w.world.KnowledgeTypeGet(k.Type)
+	if kt == nil {
+		return errNoKnowledgeType
+	}
+
+	c.Knowledges.Remove(k)
+	c.cancelled(w, id, cancelRefund(w.world, k.Paid))
+	return nil
+}
+
+// Cancel the training of a Unit. A part of the resources already spent is
+// given back, as configured in the World.
+func (c *City) CancelUnit(w *Region, id string) error {
+	u := c.Units.Get(id)
+	if u == nil {
+		return ErrNoSuchUnit
+	}
+	if u.Ticks <= 0 {
+		return errNotInProgress
+	}
+	ut := w.world.UnitTypeGet(u.Type)
+	if ut == nil {
+		return errNoUnitType
+	}
+
+	c.Units.Remove(u)
+	c.cancelled(w, id, cancelRefund(w.world, u.Paid))
+	return nil
+}
@@ -9,6 +9,7 @@ import (
 	"errors"
 	"fmt"
 	"github.com/google/uuid"
+	"github.com/jfsmig/hegemonie/pkg/utils"
 )
 
 func MakeCity() *City {
@@ -78,6 +79,13 @@ func (c *City) GetActualPopularity(w *World) int64 {
 		pop += kt.PopBonus
 	}
 
+	// Add Transient values for Artifacts
+	for _, a := range c.Artifacts {
+		if at := w.ArtifactTypeGet(a.Type); at != nil {
+			pop += at.PopBonus
+		}
+	}
+
 	return pop
 }
 
@@ -85,6 +93,8 @@ func (c *City) GetProduction(w *World) *CityProduction {
 	p := &CityProduction{
 		Buildings: ResourceModifierNoop(),
 		Knowledge: ResourceModifierNoop(),
+		Artifacts: ResourceModifierNoop(),
+		Troops:    ResourceModifierNoop(),
 	}
 
 	for _, b := range c.Buildings {
@@ -95,11 +105,36 @@ func (c *City) GetProduction(w *World) *CityProduction {
 		t := w.KnowledgeTypeGet(u.Type)
 		p.Knowledge.ComposeWith(t.Prod)
 	}
+	for _, a := range c.Artifacts {
+		if t := w.ArtifactTypeGet(a.Type); t != nil {
+			p.Artifacts.ComposeWith(t.Prod)
+		}
+	}
+	for _, u := range c.AllUnits() {
+		if t := w.UnitTypeGet(u.Type); t != nil {
+			p.Troops.ComposeWith(t.Prod)
+		}
+	}
 
 	p.Base = c.Production
 	p.Actual = c.Production
 	p.Actual.Apply(p.Buildings)
 	p.Actual.Apply(p.Knowledge)
+	p.Actual.Apply(p.Artifacts)
+
+	// The upkeep of the Troops comes last, and the part that the production
+	// cannot cover is reported as a shortage.
+	p.Actual.Multiply(p.Troops.Mult)
+	for i := 0; i < ResourceMax; i++ {
+		if inc := p.Troops.Plus[i]; inc >= 0 {
+			p.Actual[i] += uint64(inc)
+		} else if uint64(-inc) > p.Actual[i] {
+			p.Shortage[i] = uint64(-inc) - p.Actual[i]
+			p.Actual[i] = 0
+		} else {
+			p.Actual[i] -= uint64(-inc)
+		}
+	}
 	return p
 }
 
@@ -107,21 +142,28 @@ func (c *City) GetStock(w *World) *CityStock {
 	p := &CityStock{
 		Buildings: ResourceModifierNoop(),
 		Knowledge: ResourceModifierNoop(),
+		Artifacts: ResourceModifierNoop(),
 	}
 
 	for _, b := range c.Buildings {
 		t := w.BuildingTypeGet(b.Type)
 		p.Buildings.ComposeWith(t.Stock)
 	}
-	for _, b := range c.Knowledges {
-		t := w.BuildingTypeGet(b.Type)
-		p.Buildings.ComposeWith(t.Stock)
+	for _, k := range c.Knowledges {
+		t := w.KnowledgeTypeGet(k.Type)
+		p.Knowledge.ComposeWith(t.Stock)
+	}
+	for _, a := range c.Artifacts {
+		if t := w.ArtifactTypeGet(a.Type); t != nil {
+			p.Artifacts.ComposeWith(t.Stock)
+		}
 	}
 
 	p.Base = c.StockCapacity
 	p.Actual = c.StockCapacity
 	p.Actual.Apply(p.Buildings)
 	p.Actual.Apply(p.Knowledge)
+	p.Actual.Apply(p.Artifacts)
 	p.Usage = c.Stock
 	return p
 }
@@ -168,8 +210,10 @@ func (c *City) CreateArmyFromIds(w *Region, ids ...string) (*Army, error) {
 	err := c.TransferOwnUnit(a, ids...)
 	if err != nil { // Rollback
 		a.Disband(w, c, false)
+		c.Armies.Remove(a)
 		return nil, err
 	}
+	c.ChangePopularity(PopReasonArmyCreate, 0, w.world.Config.PopBonusArmyCreate)
 	return a, nil
 }
 
@@ -184,6 +228,17 @@ func (c *City) CreateArmyDefence(w *Region) (*Army, error) {
 
 // Create an Army carrying resources you own
 func (c *City) CreateTransport(w *Region, r Resources) (*Army, error) {
+	a, err := c.createTransport(w, r)
+	if err != nil {
+		return nil, err
+	}
+	c.ChangePopularity(PopReasonArmyCreate, 0, w.world.Config.PopBonusArmyCreate)
+	return a, nil
+}
+
+// Create a transport with no effect on the Popularity, for the routine
+// transfers like the tax paid to the Overlord or the market deliveries.
+func (c *City) createTransport(w *Region, r Resources) (*Army, error) {
 	if !c.Stock.GreaterOrEqualTo(r) {
 		return nil, ErrNotEnoughResources
 	}
@@ -204,6 +259,9 @@ func (c *City) ProduceLocally(w *Region, p *CityProduction) Resources {
 		}
 		c.TicksMassacres--
 	}
+	if c.TicksEpidemic > 0 {
+		prod.Multiply(MultiplierUniform(c.epidemicProdImpact(w.world)))
+	}
 	return prod
 }
 
@@ -217,6 +275,15 @@ func (c *City) Produce(w *Region) {
 	prod := c.ProduceLocally(w, prod0)
 	c.Stock.Add(prod)
 
+	// Pay the part of the upkeep of the troops that the production did not cover
+	if !prod0.Shortage.IsZero() {
+		c.PayUpkeep(w, prod0.Shortage)
+	}
+
+	// Clean the dead troops and heal the wounded
+	c.Heal(w)
+	c.spyCoolDown()
+
 	if c.Overlord != 0 {
 		if c.pOverlord != nil {
 			// Compute the expected Tax based on the local production
@@ -225,16 +292,16 @@ func (c *City) Produce(w *Region) {
 			// Ensure the tax isn't superior to the actual production (to cope with
 			// invalid tax rates)
 			tax.TrimTo(c.Stock)
-			// Then preempt the tax from the stock
-			c.Stock.Remove(tax)
 
 			// TODO(jfs): check for potential shortage
 			//  shortage := c.Tax.GreaterThan(tax)
 
+			// Then preempt the tax from the stock
 			if w.world.Config.InstantTransfers {
+				c.Stock.Remove(tax)
 				c.pOverlord.Stock.Add(tax)
-			} else {
-				c.SendResourcesTo(w, c.pOverlord, tax)
+			} else if err := c.SendResourcesTo(w, c.pOverlord, tax); err != nil {
+				utils.Logger.Warn().Err(err).Uint64("city", c.ID).Msg("tax transport")
 			}
 
 			// FIXME(jfs): notify overlord
@@ -242,47 +309,12 @@ func (c *City) Produce(w *Region) {
 		}
 	}
 
-	// ATM the stock maybe still stores resources. We use them to make the assets evolve.
-	// We arbitrarily give the preference to Units, then Buildings and eventually the
-	// Knowledge.
+	// ATM the stock maybe still stores resources. We use them to make the assets evolve,
+	// following the priorities of the production queue of the City.
+	c.Evolve(w)
 
-	for _, u := range c.Units {
-		if u.Ticks > 0 {
-			ut := w.world.UnitTypeGet(u.Type)
-			if c.Stock.GreaterOrEqualTo(ut.Cost) {
-				c.Stock.Remove(ut.Cost)
-				u.Ticks--
-				if u.Ticks <= 0 {
-					// FIXME(jfs): Notify the City
-				}
-			}
-		}
-	}
-
-	for _, b := range c.Buildings {
-		if b.Ticks > 0 {
-			bt := w.world.BuildingTypeGet(b.Type)
-			if c.Stock.GreaterOrEqualTo(bt.Cost) {
-				c.Stock.Remove(bt.Cost)
-				b.Ticks--
-				if b.Ticks <= 0 {
-					// FIXME(jfs): Notify the City
-				}
-			}
-		}
-	}
-
-	for _, k := range c.Knowledges {
-		if k.Ticks > 0 {
-			bt := w.world.KnowledgeTypeGet(k.Type)
-			if c.Stock.GreaterOrEqualTo(bt.Cost) {
-				c.Stock.Remove(bt.Cost)
-				k.Ticks--
-			}
-			if k.Ticks <= 0 {
-				// FIXME(jfs): Notify the City
-			}
-		}
+	if c.Auto {
+		c.Govern(w, stock.Actual)
 	}
 
 	// At the end of the turn, ensure we do not hold more resources than the actual
@@ -335,7 +367,10 @@ func (c *City) ConquerCity(w *World, other *City) {
 		return
 	}
 
-	//pre := other.pOverlord
+	if pre := other.pOverlord; pre != nil {
+		pre.lieges.Remove(other)
+	}
+	c.lieges.Add(other)
 	other.pOverlord = c
 	other.Overlord = c.ID
 	other.TaxRate = MultiplierUniform(w.Config.RateOverlord)
@@ -345,9 +380,19 @@ func (c *City) ConquerCity(w *World, other *City) {
 	// FIXME(jfs): Notify 'other'
 }
 
-func (c *City) SendResourcesTo(w *Region, overlord *City, amount Resources) error {
-	// FIXME(jfs): NYI
-	return errors.New("SendResourcesTo() not implemented")
+// Emit a transport Army that carries the given amount of Resources, from the
+// stock of the current City to the given City. The transport disbands at its
+// arrival and its cargo is deposited in the stock of the receiver. On its way
+// it may be intercepted by hostile armies.
+func (c *City) SendResourcesTo(w *Region, to *City, amount Resources) error {
+	if amount.IsZero() {
+		return nil
+	}
+	a, err := c.createTransport(w, amount)
+	if err != nil {
+		return err
+	}
+	return a.DeferDisband(w, to.ID)
 }
 
 func (c *City) TransferOwnResources(a *Army, r Resources) error {
@@ -363,6 +408,20 @@ func (c *City) TransferOwnResources(a *Army, r Resources) error {
 	return nil
 }
 
+func (c *City) TransferOwnArtifact(a *Army, id string) error {
+	if a.City != c {
+		return errors.New("Army not controlled by the City")
+	}
+	art := c.Artifacts.Get(id)
+	if art == nil {
+		return ErrNoSuchArtifact
+	}
+
+	c.Artifacts.Remove(art)
+	a.Artifacts.Add(art)
+	return nil
+}
+
 func (c *City) TransferOwnUnit(a *Army, units ...string) error {
 	if len(units) <= 0 || a == nil {
 		panic("EINVAL")
@@ -441,8 +500,13 @@ func (c *City) Train(w *Region, typeID uint64) (string, error) {
 	if !c.UnitAllowed(pType) {
 		return "", errors.New("Precondition Failed: no suitable building")
 	}
+	if !c.Stock.GreaterOrEqualTo(pType.Cost0) {
+		return "", ErrNotEnoughResources
+	}
 
+	c.Stock.Remove(pType.Cost0)
 	u := c.UnitCreate(w, pType)
+	u.Paid = pType.Cost0
 	return u.ID, nil
 }
 
@@ -459,9 +523,13 @@ func (c *City) Study(w *Region, typeID uint64) (string, error) {
 	if !CheckKnowledgeDependencies(c.ownedKnowledgeTypes(w), kType.Requires, kType.Conflicts) {
 		return "", errors.New("Conflict")
 	}
+	if !c.Stock.GreaterOrEqualTo(kType.Cost0) {
+		return "", ErrNotEnoughResources
+	}
 
+	c.Stock.Remove(kType.Cost0)
 	id := uuid.New().String()
-	c.Knowledges.Add(&Knowledge{ID: id, Type: typeID, Ticks: kType.Ticks})
+	c.Knowledges.Add(&Knowledge{ID: id, Type: typeID, Ticks: kType.Ticks, Paid: kType.Cost0})
 	return id, nil
 }
 
@@ -492,8 +560,9 @@ func (c *City) Build(w *Region, bID uint64) (string, error) {
 		return "", errors.New("Not enough ressources")
 	}
 
+	c.Stock.Remove(bType.Cost0)
 	id := uuid.New().String()
-	c.Buildings.Add(&Building{ID: id, Type: bID, Ticks: bType.Ticks})
+	c.Buildings.Add(&Building{ID: id, Type: bID, Ticks: bType.Ticks, Paid: bType.Cost0})
 	return id, nil
 }
 
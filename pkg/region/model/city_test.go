@@ -11,3 +11,341 @@ func TestCity_CreateArmyDefence(t *testing.T) {
 	w := World{}
 	w.Init()
 }
+
+// A map where every cell is reachable in one step, without any road listed
+type stepMapView struct{}
+
+func (m *stepMapView) Step(mapName string, src, dst uint64) (uint64, error) { return dst, nil }
+
+func (m *stepMapView) Neighbors(mapName string, id uint64) ([]uint64, error) { return nil, nil }
+
+func TestCity_TaxTransport(t *testing.T) {
+	w := World{}
+	w.Init()
+	w.mapView = &stepMapView{}
+	w.Config.InstantTransfers = false
+	w.Config.PopBonusArmyCreate = 1
+
+	r, _ := w.CreateRegion("test", "test")
+	lord, _ := r.CityCreate(1)
+	lord.StockCapacity.Set(ResourcesUniform(1000))
+	vassal, _ := r.CityCreate(2)
+	vassal.StockCapacity.Set(ResourcesUniform(1000))
+	vassal.Production.Set(ResourcesUniform(10))
+	lord.ConquerCity(&w, vassal)
+	vassal.SetUniformTaxRate(0.5)
+
+	vassal.Produce(r)
+	if !vassal.Stock.Equals(ResourcesUniform(5)) || len(vassal.Armies) != 1 {
+		t.Fatal()
+	}
+	// Paying the tax has no effect on the Popularity
+	if len(vassal.PopularityHistory) != 0 {
+		t.Fatal()
+	}
+	if len(lord.Lieges()) != 1 || !lord.Stock.IsZero() {
+		t.Fatal()
+	}
+
+	r.Move()
+	if len(vassal.Armies) != 0 || !lord.Stock.Equals(ResourcesUniform(5)) {
+		t.Fatal()
+	}
+}
+
+func TestCity_Govern(t *testing.T) {
+	w := World{}
+	w.Init()
+	w.mapView = &stepMapView{}
+	w.Config.AutoEvolve = true
+	w.Definitions.Knowledges.Add(&KnowledgeType{ID: 1, Name: "k", Ticks: 3})
+
+	r, _ := w.CreateRegion("test", "test")
+	c, _ := r.CityCreate(1)
+	c.Auto = true
+	c.StockCapacity.Set(ResourcesUniform(10))
+	c.Production.Set(ResourcesUniform(20))
+	c.Units.Add(&Unit{ID: "u0", Type: 1, Health: 1})
+	c.Units.Add(&Unit{ID: "u1", Type: 1, Health: 1})
+
+	a, _ := c.CreateArmyFromIds(r, "u0")
+	a.Cell = 2
+	a.DeferMove(r, 3, ActionArgMove{})
+	home, _ := c.CreateArmyFromIds(r, "u1")
+	home.DeferMove(r, 3, ActionArgMove{})
+	transport, _ := c.CreateTransport(r, Resources{})
+	transport.DeferDisband(r, 3)
+
+	c.Produce(r)
+	if len(a.Targets) != 1 || a.Targets[0].Action != CmdCityDefend || a.Targets[0].Cell != c.ID {
+		t.Fatal()
+	}
+	if len(home.Targets) != 0 || home.Cell != c.ID {
+		t.Fatal()
+	}
+	if len(transport.Targets) != 1 || transport.Targets[0].Action != CmdCityDisband {
+		t.Fatal()
+	}
+	if len(c.Knowledges) != 1 || !c.Stock.Equals(ResourcesUniform(10)) {
+		t.Fatal()
+	}
+
+	// Nothing new is started while an evolution is pending
+	c.Produce(r)
+	if len(c.Knowledges) != 1 {
+		t.Fatal()
+	}
+}
+
+func TestCity_Management(t *testing.T) {
+	w := World{}
+	w.Init()
+
+	r, _ := w.CreateRegion("test", "test")
+	c, _ := r.CityCreate(1)
+	c.Owner = "a"
+
+	if err := c.SetDeputy(r, "b", "b"); err != errForbidden {
+		t.Fatal()
+	}
+	if err := c.SetDeputy(r, "a", "a"); err != errInvalidCharacter {
+		t.Fatal()
+	}
+	if err := c.SetDeputy(r, "a", "b"); err != nil || c.Deputy != "b" {
+		t.Fatal()
+	}
+	if err := c.TransferOwnership(r, "b", "b"); err != errForbidden {
+		t.Fatal()
+	}
+	if err := c.TransferOwnership(r, "a", "c"); err != nil || c.Owner != "c" || c.Deputy != "" {
+		t.Fatal()
+	}
+	if err := c.Rename(r, ""); err == nil {
+		t.Fatal()
+	}
+	if err := c.Rename(r, "x"); err != nil || c.Name != "x" {
+		t.Fatal()
+	}
+}
+
+func TestCity_InitialCost(t *testing.T) {
+	w := World{}
+	w.Init()
+	w.Definitions.Units.Add(&UnitType{ID: 1, Cost0: ResourcesUniform(10), Prod: ResourceModifierNoop()})
+	w.Definitions.Buildings.Add(&BuildingType{
+		ID: 1, Cost0: ResourcesUniform(20), Stock: ResourceModifierNoop(), Prod: ResourceModifierNoop(),
+	})
+	w.Definitions.Knowledges.Add(&KnowledgeType{
+		ID: 1, Cost0: ResourcesUniform(30), Stock: ResourceModifierNoop(), Prod: ResourceModifierNoop(),
+	})
+
+	r, _ := w.CreateRegion("test", "test")
+	c, _ := r.CityCreate(1)
+	c.StockCapacity.Set(ResourcesUniform(1000))
+	c.Stock.Set(ResourcesUniform(55))
+
+	if _, err := c.Train(r, 1); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.Build(r, 1); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.Study(r, 1); err != ErrNotEnoughResources {
+		t.Fatal(err)
+	}
+	if !c.Stock.Equals(ResourcesUniform(25)) {
+		t.Fatal(c.Stock)
+	}
+}
+
+func TestCity_DismantleDisband(t *testing.T) {
+	w := World{}
+	w.Init()
+	w.Definitions.Buildings.Add(&BuildingType{
+		ID: 1, Cost0: ResourcesUniform(10), Cost: ResourcesUniform(1), Ticks: 2,
+		DismantleRefund: 0.5, PopBonusDismantle: -2,
+		Stock: ResourceModifierUniform(2.0, 0), Prod: ResourceModifierNoop(),
+	})
+	w.Definitions.Buildings.Add(&BuildingType{
+		ID: 2, Stock: ResourceModifierNoop(), Prod: ResourceModifierNoop(),
+	})
+	w.Definitions.Units.Add(&UnitType{ID: 1, PopBonusDisband: -1})
+
+	r, _ := w.CreateRegion("test", "test")
+	c, _ := r.CityCreate(1)
+	c.StockCapacity.Set(ResourcesUniform(10))
+	c.Stock.Set(ResourcesUniform(18))
+	c.Buildings.Add(&Building{ID: "b0", Type: 1})
+	c.Buildings.Add(&Building{ID: "b1", Type: 2, Ticks: 1})
+	c.Units.Add(&Unit{ID: "u0", Type: 1})
+	c.Units.Add(&Unit{ID: "u1", Type: 1, Ticks: 1})
+
+	if err := c.Dismantle(r, "b1"); err == nil {
+		t.Fatal()
+	}
+	if err := c.Dismantle(r, "b0"); err != nil {
+		t.Fatal(err)
+	}
+	if len(c.Buildings) != 1 || c.PermanentPopularity != -2 || !c.Stock.Equals(ResourcesUniform(10)) {
+		t.Fatal()
+	}
+
+	if err := c.DisbandUnits(r, "u0", "u1"); err == nil || len(c.Units) != 2 {
+		t.Fatal()
+	}
+	if err := c.DisbandUnits(r, "u0", "u0"); err != nil {
+		t.Fatal(err)
+	}
+	if len(c.Units) != 1 || c.PermanentPopularity != -3 {
+		t.Fatal()
+	}
+
+	// The refund is based on what has actually been paid
+	c.Buildings.Add(&Building{ID: "b2", Type: 1, Paid: ResourcesUniform(12)})
+	c.Stock.Zero()
+	if err := c.Dismantle(r, "b2"); err != nil || !c.Stock.Equals(ResourcesUniform(6)) {
+		t.Fatal(c.Stock)
+	}
+}
+
+func TestCity_Upkeep(t *testing.T) {
+	w := World{}
+	w.Init()
+	w.Config.StarvationImpact = 0.5
+	w.Definitions.Units.Add(&UnitType{
+		ID: 1, Health: 10, PopBonusDeath: -1,
+		Prod: ResourceModifiers{Mult: MultiplierUniform(1.0), Plus: IncrementUniform(-5)},
+	})
+
+	r, _ := w.CreateRegion("test", "test")
+	c, _ := r.CityCreate(1)
+	c.Production.Set(ResourcesUniform(8))
+	c.StockCapacity.Set(ResourcesUniform(100))
+	c.Units.Add(&Unit{ID: "u0", Type: 1, Health: 10})
+	c.Units.Add(&Unit{ID: "u1", Type: 1, Health: 4})
+
+	prod := c.GetProduction(&w)
+	if !prod.Actual.IsZero() || !prod.Shortage.Equals(ResourcesUniform(2)) {
+		t.Fatal(prod.Actual, prod.Shortage)
+	}
+
+	// The shortage is taken from the stock
+	c.Stock.Set(ResourcesUniform(3))
+	c.Produce(r)
+	if !c.Stock.Equals(ResourcesUniform(1)) || len(c.Units) != 2 {
+		t.Fatal(c.Stock)
+	}
+
+	// The stock is exhausted, the troops starve
+	c.Produce(r)
+	if !c.Stock.IsZero() || len(c.Units) != 1 || c.PermanentPopularity != -1 {
+		t.Fatal(c.Stock, len(c.Units))
+	}
+	if u := c.Units.Get("u0"); u == nil || u.Health != 5 {
+		t.Fatal()
+	}
+}
+
+func TestCity_Heal(t *testing.T) {
+	w := World{}
+	w.Init()
+	w.Config.HealRate = 0.1
+	w.Definitions.Units.Add(&UnitType{ID: 1, Health: 20, PopBonusDeath: -2, Prod: ResourceModifierNoop()})
+	w.Definitions.Buildings.Add(&BuildingType{
+		ID: 1, HealBonus: 0.15, Stock: ResourceModifierNoop(), Prod: ResourceModifierNoop(),
+	})
+
+	r, _ := w.CreateRegion("test", "test")
+	c, _ := r.CityCreate(1)
+	c.Units.Add(&Unit{ID: "u0", Type: 1, Health: 4})
+	c.Units.Add(&Unit{ID: "u1", Type: 1, Health: 19})
+	c.Units.Add(&Unit{ID: "u2", Type: 1, Health: 0})
+
+	c.Heal(r)
+	if len(c.Units) != 2 || c.PermanentPopularity != -2 {
+		t.Fatal()
+	}
+	if c.Units.Get("u0").Health != 6 || c.Units.Get("u1").Health != 20 {
+		t.Fatal()
+	}
+
+	// A hospital speeds up the healing
+	c.Buildings.Add(&Building{ID: "b0", Type: 1})
+	c.Heal(r)
+	if h := c.Units.Get("u0").Health; h != 11 {
+		t.Fatal(h)
+	}
+}
+
+func TestCity_Cancel(t *testing.T) {
+	w := World{}
+	w.Init()
+	w.Config.CancelRefund = 0.5
+	w.Definitions.Units.Add(&UnitType{
+		ID: 1, Ticks: 4, Cost0: ResourcesUniform(10), Cost: ResourcesUniform(2), Prod: ResourceModifierNoop(),
+	})
+	w.Definitions.Buildings.Add(&BuildingType{
+		ID: 1, Ticks: 4, Cost0: ResourcesUniform(20), Cost: ResourcesUniform(4),
+		Stock: ResourceModifierNoop(), Prod: ResourceModifierNoop(),
+	})
+	w.Definitions.Knowledges.Add(&KnowledgeType{
+		ID: 1, Ticks: 4, Cost0: ResourcesUniform(30),
+		Stock: ResourceModifierNoop(), Prod: ResourceModifierNoop(),
+	})
+
+	r, _ := w.CreateRegion("test", "test")
+	c, _ := r.CityCreate(1)
+	c.StockCapacity.Set(ResourcesUniform(1000))
+	c.Stock.Set(ResourcesUniform(60))
+
+	uid, err := c.Train(r, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	bid, err := c.Build(r, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	kid, err := c.Study(r, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !c.Stock.IsZero() {
+		t.Fatal(c.Stock)
+	}
+	c.Units.Get(uid).Ticks = 2
+	c.Units.Get(uid).Paid.Add(ResourcesUniform(4))
+	c.Buildings.Get(bid).Ticks = 3
+	c.Buildings.Get(bid).Paid.Add(ResourcesUniform(4))
+
+	if err = c.CancelUnit(r, "nope"); err != ErrNoSuchUnit {
+		t.Fatal(err)
+	}
+	if err = c.CancelUnit(r, uid); err != nil || len(c.Units) != 0 {
+		t.Fatal(err)
+	}
+	if !c.Stock.Equals(ResourcesUniform(7)) {
+		t.Fatal(c.Stock)
+	}
+	if err = c.CancelBuilding(r, bid); err != nil || len(c.Buildings) != 0 {
+		t.Fatal(err)
+	}
+	if err = c.CancelKnowledge(r, kid); err != nil || len(c.Knowledges) != 0 {
+		t.Fatal(err)
+	}
+	if !c.Stock.Equals(ResourcesUniform(7 + 12 + 15)) {
+		t.Fatal(c.Stock)
+	}
+
+	// Only the items in progress may be cancelled
+	c.Units.Add(&Unit{ID: "u0", Type: 1})
+	if err = c.CancelUnit(r, "u0"); err == nil {
+		t.Fatal()
+	}
+
+	// Nothing is given back for an item that paid nothing
+	c.Units.Add(&Unit{ID: "u1", Type: 1, Ticks: 1})
+	if err = c.CancelUnit(r, "u1"); err != nil || !c.Stock.Equals(ResourcesUniform(7+12+15)) {
+		t.Fatal(err)
+	}
+}
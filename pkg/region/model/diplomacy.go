@@ -0,0 +1,164 @@
+// Copyright (C) 2018-2020 Hegemonie's AUTHORS
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package region
+
+import (
+	"errors"
+	"github.com/google/uuid"
+)
+
+func validTreatyKind(kind string) bool {
+	switch kind {
+	case TreatyAlliance, TreatyNonAggression, TreatyTrade, TreatyWar:
+		return true
+	default:
+		return false
+	}
+}
+
+// Return the Treaty of the given kind between both characters, whatever
+// the character who proposed it and whether it is in force or not.
+func (r *Region) TreatyBetween(c0, c1, kind string) *Treaty {
+	for _, t := range r.Treaties {
+		if t.Kind != kind {
+			continue
+		}
+		if (t.Proposer == c0 && t.Target == c1) || (t.Proposer == c1 && t.Target == c0) {
+			return t
+		}
+	}
+	return nil
+}
+
+// Tell if a Treaty of the given kind is in force between both characters
+func (r *Region) TreatyActive(c0, c1, kind string) bool {
+	t := r.TreatyBetween(c0, c1, kind)
+	return t != nil && t.Active
+}
+
+// Tell if an alliance or a non-aggression pact holds between both characters
+func (r *Region) Peaceful(c0, c1 string) bool {
+	if c0 == "" || c1 == "" || c0 == c1 {
+		return false
+	}
+	return r.TreatyActive(c0, c1, TreatyAlliance) || r.TreatyActive(c0, c1, TreatyNonAggression)
+}
+
+// Tell if the first Character has declared a war to the second one.
+// A war is unilateral: the target is not at war with the declarer.
+func (r *Region) AtWar(declarer, target string) bool {
+	if declarer == "" || target == "" || declarer == target {
+		return false
+	}
+	t := r.TreatyBetween(declarer, target, TreatyWar)
+	return t != nil && t.Active && t.Proposer == declarer
+}
+
+// Tell if any of both characters has declared a war to the other.
+func (r *Region) Hostile(c0, c1 string) bool {
+	return r.AtWar(c0, c1) || r.AtWar(c1, c0)
+}
+
+// Return all the Treaties the given Character is a party of.
+func (r *Region) TreatiesOf(char string) []*Treaty {
+	out := make([]*Treaty, 0)
+	for _, t := range r.Treaties {
+		if t.Proposer == char || t.Target == char {
+			out = append(out, t)
+		}
+	}
+	return out
+}
+
+// Propose a Treaty to the target Character. A declaration of war is
+// immediately in force and is only possible when no pact holds.
+func (r *Region) TreatyPropose(from, to, kind string) (*Treaty, error) {
+	if from == "" || to == "" || from == to {
+		return nil, errors.New("Invalid parties")
+	}
+	if !validTreatyKind(kind) {
+		return nil, errors.New("Invalid treaty kind")
+	}
+	if r.TreatyBetween(from, to, kind) != nil {
+		return nil, errors.New("Treaty already proposed")
+	}
+	if kind == TreatyWar {
+		if r.Peaceful(from, to) {
+			return nil, errTreatyInForce
+		}
+	} else if r.Hostile(from, to) && kind != TreatyNonAggression {
+		return nil, errors.New("At war")
+	}
+
+	t := &Treaty{
+		ID:       uuid.New().String(),
+		Kind:     kind,
+		Proposer: from,
+		Target:   to,
+		Active:   kind == TreatyWar,
+	}
+	r.Treaties.Add(t)
+	return t, nil
+}
+
+// Accept the Treaty proposed to the given Character. Accepting a
+// non-aggression pact ends any war between both parties.
+func (r *Region) TreatyAccept(id, by string) error {
+	t := r.Treaties.Get(id)
+	if t == nil {
+		return ErrNoSuchTreaty
+	}
+	if t.Target != by {
+		return errForbidden
+	}
+	if t.Active {
+		return errTreatyInForce
+	}
+
+	t.Active = true
+	if t.Kind == TreatyNonAggression {
+		if w := r.TreatyBetween(t.Proposer, t.Target, TreatyWar); w != nil {
+			r.Treaties.Remove(w)
+		}
+	}
+	return nil
+}
+
+// Break the Treaty, or refuse it if it is still pending.
+// Both parties are allowed to break a Treaty, except a war that only its
+// declarer may withdraw.
+func (r *Region) TreatyBreak(id, by string) error {
+	t := r.Treaties.Get(id)
+	if t == nil {
+		return ErrNoSuchTreaty
+	}
+	if t.Target != by && t.Proposer != by {
+		return errForbidden
+	}
+	if t.Kind == TreatyWar && t.Proposer != by {
+		return errForbidden
+	}
+	r.Treaties.Remove(t)
+	return nil
+}
+
+// Return the attitude of the Army toward the given City, considering the
+// Treaties between their owners: no assault despite a pact, and an
+// assault by default in case of war.
+func (a *Army) postureIn(w *Region, c *City) int64 {
+	p := a.PostureToward(c)
+	if c == nil || a.City == nil {
+		return p
+	}
+	if p < 0 && w.Peaceful(a.City.Owner, c.Owner) {
+		return 0
+	}
+	// Only the armies with units assault by default, not the transports
+	if p == 0 && len(a.Units) > 0 && w.AtWar(a.City.Owner, c.Owner) {
+		return -1
+	}
+	return p
+}
@@ -0,0 +1,120 @@
+// Copyright (C) 2018-2020 Hegemonie's AUTHORS
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package region
+
+import "testing"
+
+func TestRegion_Treaties(t *testing.T) {
+	w := World{}
+	w.Init()
+
+	r, _ := w.CreateRegion("test", "test")
+	c0, _ := r.CityCreate(1)
+	c0.Owner = "a"
+	c1, _ := r.CityCreate(2)
+	c1.Owner = "b"
+	a0 := c0.CreateEmptyArmy(r)
+
+	if _, err := r.TreatyPropose("a", "a", TreatyAlliance); err == nil {
+		t.Fatal()
+	}
+	if _, err := r.TreatyPropose("a", "b", "nope"); err == nil {
+		t.Fatal()
+	}
+
+	// A war is in force immediately and makes the armies of the declarer
+	// hostile, except the transports. Only the declarer may withdraw it.
+	war, err := r.TreatyPropose("a", "b", TreatyWar)
+	if err != nil || !r.AtWar("a", "b") || r.AtWar("b", "a") || !r.Hostile("b", "a") {
+		t.Fatal()
+	}
+	if a0.postureIn(r, c1) != 0 {
+		t.Fatal()
+	}
+	a0.Units.Add(&Unit{ID: "u0", Type: 1, Health: 1})
+	if a0.postureIn(r, c1) >= 0 {
+		t.Fatal()
+	}
+	if err = r.TreatyBreak(war.ID, "b"); err != errForbidden {
+		t.Fatal(err)
+	}
+	if _, err = r.TreatyPropose("b", "a", TreatyAlliance); err == nil {
+		t.Fatal()
+	}
+
+	// A non-aggression pact requires the acceptation and ends the war
+	pact, err := r.TreatyPropose("b", "a", TreatyNonAggression)
+	if err != nil || r.Peaceful("a", "b") {
+		t.Fatal()
+	}
+	if err = r.TreatyAccept(pact.ID, "b"); err != errForbidden {
+		t.Fatal()
+	}
+	if err = r.TreatyAccept(pact.ID, "a"); err != nil {
+		t.Fatal(err)
+	}
+	if !r.Peaceful("a", "b") || r.AtWar("a", "b") || r.Treaties.Get(war.ID) != nil {
+		t.Fatal()
+	}
+
+	// The pact prevails on the postures and forbids the assaults
+	a0.SetPostureCity(c1.ID, -1)
+	if a0.postureIn(r, c1) != 0 {
+		t.Fatal()
+	}
+	if err = a0.DeferAttack(r, c1.ID, ActionArgAssault{}); err != errTreatyInForce {
+		t.Fatal()
+	}
+
+	if err = r.TreatyBreak(pact.ID, "a"); err != nil {
+		t.Fatal(err)
+	}
+	if len(r.TreatiesOf("a")) != 0 || a0.postureIn(r, c1) >= 0 {
+		t.Fatal()
+	}
+	if err = a0.DeferAttack(r, c1.ID, ActionArgAssault{}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestRegion_Alliance(t *testing.T) {
+	w := World{}
+	w.Init()
+	w.Definitions.Units.Add(&UnitType{ID: 1, Health: 20, Attack: 10})
+
+	r, _ := w.CreateRegion("test", "test")
+	c0, _ := r.CityCreate(1)
+	c0.Owner = "a"
+	c1, _ := r.CityCreate(2)
+	c1.Owner = "b"
+	c2, _ := r.CityCreate(3)
+	c2.Owner = "c"
+
+	alliance, _ := r.TreatyPropose("a", "b", TreatyAlliance)
+	if err := r.TreatyAccept(alliance.ID, "b"); err != nil {
+		t.Fatal(err)
+	}
+
+	// The assault ordered before the pact is cancelled on arrival
+	a0 := c0.CreateEmptyArmy(r)
+	a0.Cell = c1.ID
+	a0.Units.Add(&Unit{ID: "a0", Type: 1, Health: 20})
+	if a0.JoinCityAttack(r, c1) || c1.Assault != nil {
+		t.Fatal()
+	}
+
+	// The ally joins the defense of the City under assault
+	a2 := c2.CreateEmptyArmy(r)
+	a2.Cell = c1.ID
+	a2.Units.Add(&Unit{ID: "a2", Type: 1, Health: 20})
+	if !a2.JoinCityAttack(r, c1) {
+		t.Fatal()
+	}
+	a0.ApplyAgressivity(r)
+	if a0.Fight != c1.Assault.ID || !c1.Assault.Defense.Has(a0.ID) {
+		t.Fatal()
+	}
+}
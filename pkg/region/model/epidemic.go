@@ -0,0 +1,134 @@
+// Copyright (C) 2018-2020 Hegemonie's AUTHORS
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package region
+
+import (
+	"math/rand"
+
+	"github.com/jfsmig/hegemonie/pkg/utils"
+)
+
+// Return the total Health of the current City (permanent + transient)
+func (c *City) GetActualHealth(w *World) int64 {
+	var h int64 = c.PermanentHealth
+	for _, b := range c.Buildings {
+		if bt := w.BuildingTypeGet(b.Type); bt != nil {
+			h += bt.HealthBonus
+		}
+	}
+	for _, k := range c.Knowledges {
+		if kt := w.KnowledgeTypeGet(k.Type); kt != nil {
+			h += kt.HealthBonus
+		}
+	}
+	return h
+}
+
+// Return the ratio of the impact of any epidemic that is absorbed by the
+// City, in [0,1[. A City without positive Health absorbs nothing.
+func (c *City) epidemicResistance(w *World) float64 {
+	h := float64(c.GetActualHealth(w))
+	if h <= 0 {
+		return 0
+	}
+	return h / (h + 100)
+}
+
+func (c *City) epidemicProdImpact(w *World) float64 {
+	loss := 1.0 - w.Config.EpidemicProdImpact
+	return 1.0 - loss*(1.0-c.epidemicResistance(w))
+}
+
+// Start an epidemic in the City, unless one is already ongoing
+func (c *City) Infect(w *World) {
+	if c.TicksEpidemic == 0 {
+		c.TicksEpidemic = w.Config.EpidemicDuration
+	}
+}
+
+// Roll the dice to check if the City gets infected, given the base probability
+func (c *City) maybeInfect(w *World, rate float64) {
+	if c.TicksEpidemic > 0 || rate <= 0 {
+		return
+	}
+	if rand.Float64() < rate*(1.0-c.epidemicResistance(w)) {
+		c.Infect(w)
+	}
+}
+
+// Exchange the epidemics between the Army and the City it is in contact with.
+func (a *Army) epidemicContact(r *Region, c *City) {
+	w := r.world
+	if c.TicksEpidemic > 0 && a.TicksEpidemic == 0 {
+		if rand.Float64() < w.Config.EpidemicSpreadRate {
+			a.TicksEpidemic = w.Config.EpidemicDuration
+		}
+	} else if a.TicksEpidemic > 0 {
+		c.maybeInfect(w, w.Config.EpidemicSpreadRate)
+	}
+}
+
+func epidemicHurt(units SetOfUnits, w *World, ratio float64) {
+	for _, u := range units {
+		ut := w.UnitTypeGet(u.Type)
+		if ut == nil || u.Health <= 1 {
+			continue
+		}
+		loss := uint32(float64(ut.Health)*ratio + 0.5)
+		if loss >= u.Health {
+			u.Health = 1
+		} else {
+			u.Health -= loss
+		}
+	}
+}
+
+// Play one round of the epidemics in the Region: the sick cities contaminate
+// their neighbors along the roads, new outbreaks randomly start, then the
+// units of the sick cities and armies suffer and the epidemics decline.
+func (r *Region) Epidemic() {
+	w := r.world
+
+	sick := make([]*City, 0)
+	for _, c := range r.Cities {
+		if c.TicksEpidemic > 0 {
+			sick = append(sick, c)
+		}
+	}
+	for _, c := range sick {
+		// The neighbors are the next cities on each road leaving the City
+		neighbors, err := r.NeighborCities(c.ID)
+		if err != nil {
+			utils.Logger.Warn().Err(err).Uint64("city", c.ID).Msg("epidemic")
+			continue
+		}
+		for _, other := range neighbors {
+			if other.TicksEpidemic == 0 {
+				other.maybeInfect(w, w.Config.EpidemicSpreadRate)
+			}
+		}
+	}
+
+	for _, c := range r.Cities {
+		if c.TicksEpidemic == 0 {
+			c.maybeInfect(w, w.Config.EpidemicOutbreakRate)
+		}
+	}
+
+	for _, c := range r.Cities {
+		ratio := w.Config.EpidemicUnitImpact * (1.0 - c.epidemicResistance(w))
+		if c.TicksEpidemic > 0 {
+			epidemicHurt(c.Units, w, ratio)
+			c.TicksEpidemic--
+		}
+		for _, a := range c.Armies {
+			if a.TicksEpidemic > 0 {
+				epidemicHurt(a.Units, w, ratio)
+				a.TicksEpidemic--
+			}
+		}
+	}
+}
@@ -0,0 +1,64 @@
+// Copyright (C) 2018-2020 Hegemonie's AUTHORS
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package region
+
+import "testing"
+
+func TestRegion_Epidemic(t *testing.T) {
+	w := World{}
+	w.Init()
+	w.mapView = &lineMapView{}
+	w.Config.EpidemicSpreadRate = 1
+	w.Config.EpidemicDuration = 2
+	w.Config.EpidemicProdImpact = 0.5
+	w.Config.EpidemicUnitImpact = 0.1
+	w.Definitions.Units.Add(&UnitType{ID: 1, Health: 10, Prod: ResourceModifierNoop()})
+	w.Definitions.Buildings.Add(&BuildingType{ID: 1, HealthBonus: 1 << 50})
+
+	r, _ := w.CreateRegion("test", "test")
+	c0, _ := r.CityCreate(1)
+	c0.Production.Set(ResourcesUniform(10))
+	c0.StockCapacity.Set(ResourcesUniform(100))
+	c0.Units.Add(&Unit{ID: "u0", Type: 1, Health: 10})
+	c1, _ := r.CityCreate(2)
+	c2, _ := r.CityCreate(3)
+	c2.Buildings.Add(&Building{ID: "b0", Type: 1})
+
+	c0.Infect(&w)
+	r.Produce()
+	if !c0.Stock.Equals(ResourcesUniform(5)) || c0.Units.Get("u0").Health != 9 {
+		t.Fatal()
+	}
+	if c0.TicksEpidemic != 1 || c1.TicksEpidemic != 1 || c2.TicksEpidemic != 0 {
+		t.Fatal()
+	}
+
+	r.Produce()
+	r.Produce()
+	if c0.TicksEpidemic != 0 || c1.TicksEpidemic != 0 || c2.TicksEpidemic != 0 {
+		t.Fatal()
+	}
+}
+
+func TestRegion_EpidemicAlongRoads(t *testing.T) {
+	w := World{}
+	w.Init()
+	w.mapView = &lineMapView{}
+	w.Config.EpidemicSpreadRate = 1
+	w.Config.EpidemicDuration = 2
+
+	r, _ := w.CreateRegion("test", "test")
+	c0, _ := r.CityCreate(1)
+	c1, _ := r.CityCreate(4)
+	c2, _ := r.CityCreate(8)
+
+	// The road cells between the cities carry the epidemic, the next City stops it
+	c0.Infect(&w)
+	r.Epidemic()
+	if c1.TicksEpidemic != 1 || c2.TicksEpidemic != 0 {
+		t.Fatal()
+	}
+}
@@ -14,6 +14,29 @@ var (
 	errCityNotFound       = errors.New("No such City")
 	errForbidden          = errors.New("Insufficient permissions")
 	errNotImplemented     = errors.New("NYI")
+	errNotInFight         = errors.New("Army not involved in a fight")
+	errArmyInFight        = errors.New("Army involved in a fight")
+	errArmyNotHome        = errors.New("Army not at home")
+	errAssaultOwnCity     = errors.New("Assault of the own City forbidden")
+	errAssaultOwnChar     = errors.New("Assault of the own Character forbidden")
+	errInvalidCity        = errors.New("Invalid City")
+	errSpyCooldown        = errors.New("Intelligence operation too recent")
+	errInvalidCharacter   = errors.New("Invalid Character")
+	errTreatyInForce      = errors.New("Treaty in force")
+	errNotInProgress      = errors.New("Not in progress")
+	errNoBuildingType     = errors.New("Building Type not found")
+	errNoKnowledgeType    = errors.New("Knowledge Type not found")
+	errNoUnitType         = errors.New("Unit Type not found")
+	errUnderConstruction  = errors.New("Building under construction")
+	errUnderTraining      = errors.New("Unit under training")
+	errAlreadyOwner       = errors.New("Character already owns a City")
+	errInvalidStrategy    = errors.New("Invalid strategy")
+	ErrNoSuchTreaty       = errors.New("No such Treaty")
+	ErrNoSuchOffer        = errors.New("No such Offer")
+	ErrNoSuchBuilding     = errors.New("No such Building")
 	ErrNoSuchUnit         = errors.New("No such Unit")
+	ErrNoSuchArtifact     = errors.New("No such Artifact")
+	ErrNoSuchKnowledge    = errors.New("No such Knowledge")
+	ErrNoSuchItem         = errors.New("No such item in progress")
 	ErrNotEnoughResources = errors.New("Not enough resources")
 )
@@ -6,7 +6,10 @@
 package region
 
 import (
+	"encoding/json"
 	"sort"
+
+	"github.com/jfsmig/hegemonie/pkg/utils"
 )
 
 func (s SetOfFights) Len() int      { return len(s) }
@@ -36,3 +39,234 @@ func (s SetOfFights) SliceByCell(cell uint64) []*Fight {
 	}
 	return s[start:]
 }
+
+func (s SetOfFights) Get(id string) *Fight {
+	for _, f := range s {
+		if f.ID == id {
+			return f
+		}
+	}
+	return nil
+}
+
+func (s *SetOfFights) Remove(f *Fight) {
+	for i, x := range *s {
+		if x == f {
+			*s = append((*s)[:i], (*s)[i+1:]...)
+			return
+		}
+	}
+}
+
+// Return all the Units still alive on a side of the Fight
+func liveUnits(side SetOfArmies) []*Unit {
+	out := make([]*Unit, 0)
+	for _, a := range side {
+		for _, u := range a.Units {
+			if u.Health > 0 {
+				out = append(out, u)
+			}
+		}
+	}
+	return out
+}
+
+// Return the multiplier of the Attack of the current UnitType against the
+// given UnitType.
+func (ut *UnitType) BonusAgainst(target uint64) float64 {
+	for _, b := range ut.Bonus {
+		if b.Type == target {
+			return b.Mult
+		}
+	}
+	return 1.0
+}
+
+// Compute the amount of damage each target receives during one round, from
+// all the hitters. The Attack of each hitter is evenly spread among the
+// targets, then the Defense and the Armor of each target apply.
+// The output is aligned with the targets.
+func (w *World) fightDamage(hitters, targets []*Unit) []uint32 {
+	out := make([]uint32, len(targets))
+	if len(targets) <= 0 {
+		return out
+	}
+
+	raw := make([]float64, len(targets))
+	for _, h := range hitters {
+		ht := w.UnitTypeGet(h.Type)
+		if ht == nil {
+			continue
+		}
+		share := h.Capacity(ht) * float64(ht.Attack) / float64(len(targets))
+		for i, t := range targets {
+			raw[i] += share * ht.BonusAgainst(t.Type)
+		}
+	}
+
+	for i, t := range targets {
+		tt := w.UnitTypeGet(t.Type)
+		dmg := raw[i]
+		if tt != nil {
+			dmg -= float64(tt.Defense)
+			dmg *= float64(100-tt.Armor) / 100.0
+		}
+		if dmg > 0 {
+			out[i] = uint32(dmg + 0.5)
+		}
+	}
+	return out
+}
+
+// Apply the damage to the targets, the slices must be aligned.
+func fightInflict(targets []*Unit, damage []uint32) {
+	for i, u := range targets {
+		if u.Health <= damage[i] {
+			u.Health = 0
+		} else {
+			u.Health -= damage[i]
+		}
+	}
+}
+
+// Remove the dead Units from the Armies of both sides, then remove from
+// the Fight the Armies without any Unit left. The emptied Armies are destroyed
+// and the Resources they carried are looted by the first Army of the other side.
+// The City of each dead Unit loses popularity, the City of the first Army of
+// the other side is credited with the kill.
+func (f *Fight) clean(r *Region) {
+	looter := func(side SetOfArmies) *Army {
+		for _, a := range side {
+			if len(a.Units) > 0 {
+				return a
+			}
+		}
+		return nil
+	}
+
+	bury := func(side SetOfArmies) []*UnitType {
+		dead := make([]*UnitType, 0)
+		for _, a := range side {
+			for _, u := range append(SetOfUnits{}, a.Units...) {
+				if u.Health > 0 {
+					continue
+				}
+				a.Units.Remove(u)
+				if ut := r.world.UnitTypeGet(u.Type); ut != nil {
+					a.City.ChangePopularity(PopReasonDeath, ut.ID, ut.PopBonusDeath)
+					dead = append(dead, ut)
+				}
+			}
+		}
+		return dead
+	}
+	deadAtt, deadDef := bury(f.Attack), bury(f.Defense)
+	lootAtt, lootDef := looter(f.Attack), looter(f.Defense)
+
+	credit := func(killer *Army, dead []*UnitType) {
+		if killer == nil {
+			return
+		}
+		for _, ut := range dead {
+			killer.City.ChangePopularity(PopReasonKill, ut.ID, ut.PopBonusKill)
+		}
+	}
+	credit(lootAtt, deadDef)
+	credit(lootDef, deadAtt)
+
+	destroy := func(side *SetOfArmies, winner *Army) {
+		for _, a := range append(SetOfArmies{}, *side...) {
+			if len(a.Units) > 0 {
+				continue
+			}
+			if winner != nil {
+				winner.Stock.Add(a.Stock)
+				a.Stock.Zero()
+				for _, art := range a.Artifacts {
+					winner.Artifacts.Add(art)
+				}
+				a.Artifacts = a.Artifacts[:0]
+			}
+			side.Remove(a)
+			a.Fight = ""
+			a.City.Armies.Remove(a)
+		}
+	}
+	destroy(&f.Attack, lootDef)
+	destroy(&f.Defense, lootAtt)
+}
+
+// Play one round of the Fight: both sides hit simultaneously, then the dead
+// Units are removed. Return true if the Fight is over, i.e. at least one side
+// has no Unit left.
+func (f *Fight) Round(r *Region) bool {
+	att, def := liveUnits(f.Attack), liveUnits(f.Defense)
+	dmgToDef := r.world.fightDamage(att, def)
+	dmgToAtt := r.world.fightDamage(def, att)
+	fightInflict(def, dmgToDef)
+	fightInflict(att, dmgToAtt)
+
+	f.clean(r)
+	return len(f.Attack) <= 0 || len(f.Defense) <= 0
+}
+
+// Terminate the Fight. The attackers win only if they remain alone on the
+// battlefield, then the post-victory actions requested in their assault
+// commands are applied to the local City. The Artifacts of the City are only
+// captured on conquest, by the Army that becomes the Overlord.
+func (f *Fight) End(r *Region) {
+	pCity := r.CityGetAt(f.Cell)
+	victory := len(f.Attack) > 0 && len(f.Defense) <= 0
+
+	var overlord, broken, massacred bool
+	for _, a := range f.Attack {
+		a.Fight = ""
+		if len(a.Targets) <= 0 {
+			continue
+		}
+		cmd := a.Targets[0]
+		if cmd.Action != CmdCityAttack || cmd.Cell != f.Cell {
+			continue
+		}
+		a.PopCommand()
+		if !victory || pCity == nil {
+			continue
+		}
+
+		var args ActionArgAssault
+		if cmd.Args != "" {
+			if err := json.Unmarshal([]byte(cmd.Args), &args); err != nil {
+				utils.Logger.Warn().Err(err).Str("army", a.ID).Msg("assault args")
+				continue
+			}
+		}
+		if args.Overlord && !overlord {
+			overlord = true
+			a.Conquer(r.world, pCity)
+			a.CaptureArtifacts(r, pCity)
+		}
+		if args.Break && !broken {
+			broken = true
+			a.BreakBuilding(r, pCity)
+		}
+		if args.Massacre && !massacred {
+			massacred = true
+			a.Massacre(r, pCity)
+		}
+	}
+
+	for _, a := range f.Defense {
+		a.Fight = ""
+		// The Army spawned to defend the City goes back home
+		if pCity != nil && a.City == pCity && a.Cell == pCity.ID && len(a.Targets) <= 0 {
+			a.Disband(r, pCity, false)
+			pCity.Armies.Remove(a)
+			pCity.ChangePopularity(PopReasonArmyDisband, 0, r.world.Config.PopBonusArmyDisband)
+		}
+	}
+
+	if pCity != nil && pCity.Assault != nil && pCity.Assault.ID == f.ID {
+		pCity.Assault = nil
+	}
+	r.Fights.Remove(f)
+}
@@ -4,3 +4,148 @@
 // file, You can obtain one at http://mozilla.org/MPL/2.0/.
 
 package region
+
+import (
+	"testing"
+)
+
+func TestFightRound(t *testing.T) {
+	w := World{}
+	w.Init()
+	w.Definitions.Units.Add(&UnitType{ID: 1, Health: 20, HealthFactor: 0.5, Attack: 10})
+
+	r, err := w.CreateRegion("test", "test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	c0, _ := r.CityCreate(1)
+	c1, _ := r.CityCreate(2)
+	c1.Units.Add(&Unit{ID: "d0", Type: 1, Health: 20})
+
+	att := c0.CreateEmptyArmy(r)
+	att.Cell = c1.ID
+	att.Units.Add(&Unit{ID: "a0", Type: 1, Health: 20})
+	att.Units.Add(&Unit{ID: "a1", Type: 1, Health: 20})
+	if err = att.DeferAttack(r, c1.ID, ActionArgAssault{Massacre: true}); err != nil {
+		t.Fatal(err)
+	}
+
+	if !att.JoinCityAttack(r, c1) {
+		t.Fatal()
+	}
+	if len(r.Fights) != 1 || c1.Assault == nil || len(c1.Units) != 0 {
+		t.Fatal()
+	}
+	f := r.Fights[0]
+	if f.Cell != c1.ID || len(f.Attack) != 1 || len(f.Defense) != 1 {
+		t.Fatal()
+	}
+
+	// Round 1: the defender takes 20 damages and dies, each attacker takes 5
+	if !f.Round(r) {
+		t.Fatal()
+	}
+	if len(f.Defense) != 0 || len(att.Units) != 2 {
+		t.Fatal()
+	}
+	for _, u := range att.Units {
+		if u.Health != 15 {
+			t.Fatal(u.Health)
+		}
+	}
+
+	// A raid captures no Artifact
+	c1.Artifacts.Add(&Artifact{ID: "art", Type: 1})
+	f.End(r)
+	if len(r.Fights) != 0 || c1.Assault != nil || att.Fight != "" {
+		t.Fatal()
+	}
+	if len(att.Targets) != 0 || c1.TicksMassacres != 1 {
+		t.Fatal()
+	}
+	if len(c1.Artifacts) != 1 || len(att.Artifacts) != 0 {
+		t.Fatal()
+	}
+
+	// A conquest does
+	c1.Units.Add(&Unit{ID: "d1", Type: 1, Health: 1})
+	if err = att.DeferAttack(r, c1.ID, ActionArgAssault{Overlord: true}); err != nil {
+		t.Fatal(err)
+	}
+	if !att.JoinCityAttack(r, c1) || !r.Fights[0].Round(r) {
+		t.Fatal()
+	}
+	r.Fights[0].End(r)
+	if len(c1.Artifacts) != 0 || len(att.Artifacts) != 1 || c1.Overlord != c0.ID {
+		t.Fatal()
+	}
+}
+
+func TestFightDamage(t *testing.T) {
+	w := World{}
+	w.Init()
+	w.Definitions.Units.Add(&UnitType{ID: 1, Health: 100, Attack: 40,
+		Bonus: []UnitTypeBonus{{Type: 2, Mult: 2}}})
+	w.Definitions.Units.Add(&UnitType{ID: 2, Health: 100, Defense: 10, Armor: 50})
+
+	hitters := []*Unit{{ID: "h", Type: 1, Health: 100}}
+	targets := []*Unit{{ID: "t0", Type: 1, Health: 100}, {ID: "t1", Type: 2, Health: 100}}
+	dmg := w.fightDamage(hitters, targets)
+	// 40/2 on the first target, (40/2*2 - 10) * 50% on the second
+	if dmg[0] != 20 || dmg[1] != 15 {
+		t.Fatal(dmg)
+	}
+}
+
+func TestFightFleaFlip(t *testing.T) {
+	w := World{}
+	w.Init()
+	w.Config.FleaPenalty = 0.5
+	w.Config.PopBonusArmyFlip = -3
+	w.Definitions.Units.Add(&UnitType{ID: 1, Health: 20, Attack: 10})
+
+	r, _ := w.CreateRegion("test", "test")
+	c0, _ := r.CityCreate(1)
+	c1, _ := r.CityCreate(2)
+	c1.Units.Add(&Unit{ID: "d0", Type: 1, Health: 20})
+
+	a0 := c0.CreateEmptyArmy(r)
+	a0.Cell = c1.ID
+	a0.Units.Add(&Unit{ID: "a0", Type: 1, Health: 20})
+	a0.DeferAttack(r, c1.ID, ActionArgAssault{})
+	a0.JoinCityAttack(r, c1)
+
+	a1 := c0.CreateEmptyArmy(r)
+	a1.Cell = c1.ID
+	a1.Units.Add(&Unit{ID: "a1", Type: 1, Health: 20})
+	a1.JoinCityAttack(r, c1)
+
+	f := r.Fights[0]
+	for _, a := range f.Defense {
+		if err := a.Flip(r); a.City == c1 && err != errAssaultOwnCity {
+			t.Fatal(err)
+		}
+	}
+	if err := a1.Flip(r); err != nil {
+		t.Fatal(err)
+	}
+	if len(f.Attack) != 1 || len(f.Defense) != 2 || a1.Fight != f.ID {
+		t.Fatal()
+	}
+	if c0.PermanentPopularity != -3 {
+		t.Fatal()
+	}
+
+	if err := a0.Flea(r); err != nil {
+		t.Fatal(err)
+	}
+	if len(f.Attack) != 0 || a0.Fight != "" || len(a0.Targets) != 0 {
+		t.Fatal()
+	}
+	if a0.Units[0].Health != 10 {
+		t.Fatal()
+	}
+	if err := a0.Flea(r); err != errNotInFight {
+		t.Fatal()
+	}
+}
@@ -0,0 +1,79 @@
+// Copyright (C) 2018-2020 Hegemonie's AUTHORS
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package region
+
+// Play the automatic governor of a City in "auto" mode: all the armies are
+// recalled to defend the City and, if the World allows it, the resources that
+// would exceed the capacity are invested in a conservative evolution.
+func (c *City) Govern(w *Region, capacity Resources) {
+	c.governArmies(w)
+	if w.world.Config.AutoEvolve && c.stockFull(capacity) && !c.evolving() {
+		c.governEvolution(w)
+	}
+}
+
+// Recall the armies of the City, the armies already at home drop their
+// commands. The transports (armies that carry no Unit)
+// are left untouched so that they still deliver their cargo, and so are the
+// armies involved in a Fight.
+func (c *City) governArmies(w *Region) {
+	for _, a := range c.Armies {
+		if a.Fight != "" || len(a.Units) <= 0 {
+			continue
+		}
+		if a.Cell == c.ID && len(a.Targets) <= 0 {
+			continue
+		}
+		if len(a.Targets) == 1 && a.Targets[0].Action == CmdCityDefend && a.Targets[0].Cell == c.ID {
+			continue
+		}
+		a.Targets = a.Targets[:0]
+		// An Army already at home just stays there
+		if a.Cell != c.ID {
+			a.DeferDefend(w, c.ID)
+		}
+	}
+}
+
+// Tell if at least one Resource reached the capacity of the City
+func (c *City) stockFull(capacity Resources) bool {
+	for i := 0; i < ResourceMax; i++ {
+		if capacity[i] > 0 && c.Stock[i] >= capacity[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Tell if a Knowledge or a Building is pending in the City
+func (c *City) evolving() bool {
+	for _, k := range c.Knowledges {
+		if k.Ticks > 0 {
+			return true
+		}
+	}
+	for _, b := range c.Buildings {
+		if b.Ticks > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// Start the first Knowledge available, or the first affordable Building if
+// no Knowledge is available.
+func (c *City) governEvolution(w *Region) {
+	for _, kt := range c.KnowledgeFrontier(w.world) {
+		if _, err := c.Study(w, kt.ID); err == nil {
+			return
+		}
+	}
+	for _, bt := range c.BuildingFrontier(w.world) {
+		if _, err := c.Build(w, bt.ID); err == nil {
+			return
+		}
+	}
+}
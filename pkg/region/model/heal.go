@@ -0,0 +1,72 @@
+// Copyright (C) 2018-2020 Hegemonie's AUTHORS
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package region
+
+// Return the ratio of its maximal Health each wounded Unit staying in the
+// City recovers at each production round. The base rate of the World is
+// increased by the Buildings and the Knowledge of the City, then boosted
+// by the Health of the City.
+func (c *City) GetHealRate(w *World) float64 {
+	rate := w.Config.HealRate
+	for _, b := range c.Buildings {
+		if bt := w.BuildingTypeGet(b.Type); bt != nil && b.Ticks <= 0 {
+			rate += bt.HealBonus
+		}
+	}
+	for _, k := range c.Knowledges {
+		if kt := w.KnowledgeTypeGet(k.Type); kt != nil && k.Ticks <= 0 {
+			rate += kt.HealBonus
+		}
+	}
+	if rate <= 0 {
+		return 0
+	}
+	return rate * (1.0 + c.epidemicResistance(w))
+}
+
+// Remove the dead Units from the set. The City loses the popularity
+// configured for the death of each of them.
+func (c *City) buryDead(w *World, units *SetOfUnits) {
+	for _, u := range append(SetOfUnits{}, (*units)...) {
+		if u.Health > 0 {
+			continue
+		}
+		units.Remove(u)
+		if ut := w.UnitTypeGet(u.Type); ut != nil {
+			c.ChangePopularity(PopReasonDeath, ut.ID, ut.PopBonusDeath)
+		}
+	}
+}
+
+// Play one round of healing: the dead Units of the City and of its Armies
+// are removed, then the wounded Units staying in the City recover a part of
+// their maximal Health.
+func (c *City) Heal(w *Region) {
+	c.buryDead(w.world, &c.Units)
+	for _, a := range c.Armies {
+		c.buryDead(w.world, &a.Units)
+	}
+
+	rate := c.GetHealRate(w.world)
+	if rate <= 0 {
+		return
+	}
+	for _, u := range c.Units {
+		ut := w.world.UnitTypeGet(u.Type)
+		if ut == nil || u.Health >= ut.Health {
+			continue
+		}
+		gain := uint32(float64(ut.Health)*rate + 0.5)
+		if gain == 0 {
+			gain = 1
+		}
+		if gain >= ut.Health-u.Health {
+			u.Health = ut.Health
+		} else {
+			u.Health += gain
+		}
+	}
+}
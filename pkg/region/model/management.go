@@ -0,0 +1,86 @@
+// Copyright (C) 2018-2020 Hegemonie's AUTHORS
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package region
+
+import "errors"
+
+// Prepare a notification for each Character managing the City, plus the
+// extra Characters. Each Character is notified once.
+func (c *City) notifyManagers(w *Region, extra ...string) []EventCity {
+	notified := make(map[string]bool)
+	out := make([]EventCity, 0)
+	for _, char := range append([]string{c.Owner, c.Deputy}, extra...) {
+		if char == "" || notified[char] {
+			continue
+		}
+		notified[char] = true
+		out = append(out, w.world.notifier.City(char).Item(c))
+	}
+	return out
+}
+
+// Name a Deputy for the City, or revoke the current one if :deputy: is empty.
+// Only the Owner of the City is allowed to do so.
+func (c *City) SetDeputy(w *Region, by, deputy string) error {
+	if by != c.Owner {
+		return errForbidden
+	}
+	if deputy == c.Owner {
+		return errInvalidCharacter
+	}
+
+	prev := c.Deputy
+	c.Deputy = deputy
+	for _, evt := range c.notifyManagers(w, prev) {
+		evt.Deputy(prev, deputy).Send()
+	}
+	return nil
+}
+
+// Give the City to another Character. Only the Owner of the City is allowed
+// to do so, and the Deputy is revoked.
+func (c *City) TransferOwnership(w *Region, by, to string) error {
+	if by != c.Owner {
+		return errForbidden
+	}
+	if to == "" || to == c.Owner {
+		return errInvalidCharacter
+	}
+
+	prev, prevDeputy := c.Owner, c.Deputy
+	c.Owner = to
+	c.Deputy = ""
+	for _, evt := range c.notifyManagers(w, prev, prevDeputy) {
+		evt.Owner(prev, to).Send()
+	}
+	return nil
+}
+
+// Change the display name of the City
+func (c *City) Rename(w *Region, name string) error {
+	if name == "" {
+		return errors.New("Invalid name")
+	}
+
+	prev := c.Name
+	c.Name = name
+	for _, evt := range c.notifyManagers(w) {
+		evt.Rename(prev, name).Send()
+	}
+	return nil
+}
+
+// Change the display name of the Army
+func (a *Army) Rename(w *Region, name string) error {
+	if name == "" {
+		return errors.New("Invalid name")
+	}
+
+	prev := a.Name
+	a.Name = name
+	w.world.notifier.Army(a.City).Item(a).Rename(prev).Send()
+	return nil
+}
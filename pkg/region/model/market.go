@@ -0,0 +1,86 @@
+// Copyright (C) 2018-2020 Hegemonie's AUTHORS
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package region
+
+import (
+	"errors"
+	"github.com/google/uuid"
+)
+
+// Post an Offer on the market of the Region. The Goods are immediately
+// preempted from the Stock of the City.
+func (c *City) MarketPost(w *Region, goods, price Resources) (*Offer, error) {
+	if goods.IsZero() || price.IsZero() {
+		return nil, errors.New("Invalid offer")
+	}
+	if !c.Stock.GreaterOrEqualTo(goods) {
+		return nil, ErrNotEnoughResources
+	}
+
+	o := &Offer{
+		ID:    uuid.New().String(),
+		City:  c.ID,
+		Goods: goods,
+		Price: price,
+	}
+	c.Stock.Remove(goods)
+	w.Market.Add(o)
+	return o, nil
+}
+
+// Withdraw an Offer posted by the City and give the Goods back.
+func (c *City) MarketCancel(w *Region, id string) error {
+	o := w.Market.Get(id)
+	if o == nil {
+		return ErrNoSuchOffer
+	}
+	if o.City != c.ID {
+		return errForbidden
+	}
+
+	w.Market.Remove(o)
+	c.Stock.Add(o.Goods)
+	return nil
+}
+
+// Accept an Offer posted by another City: the current City pays the Price
+// and receives the Goods. Both transfers happen instantly or with transports,
+// depending on the configuration of the World. The transfers between the
+// parties of a trade treaty are always instant.
+func (c *City) MarketAccept(w *Region, id string) error {
+	o := w.Market.Get(id)
+	if o == nil {
+		return ErrNoSuchOffer
+	}
+	if o.City == c.ID {
+		return errors.New("Own offer")
+	}
+	seller := w.CityGet(o.City)
+	if seller == nil {
+		return errCityNotFound
+	}
+	if w.Hostile(c.Owner, seller.Owner) {
+		return errors.New("At war")
+	}
+	if !c.Stock.GreaterOrEqualTo(o.Price) {
+		return ErrNotEnoughResources
+	}
+
+	w.Market.Remove(o)
+	if w.world.Config.InstantTransfers || w.TreatyActive(c.Owner, seller.Owner, TreatyTrade) {
+		c.Stock.Remove(o.Price)
+		seller.Stock.Add(o.Price)
+		c.Stock.Add(o.Goods)
+		return nil
+	}
+
+	// The Goods are given back to the seller, just to let it emit the transport
+	seller.Stock.Add(o.Goods)
+	if err := seller.SendResourcesTo(w, c, o.Goods); err != nil {
+		return err
+	}
+	return c.SendResourcesTo(w, seller, o.Price)
+}
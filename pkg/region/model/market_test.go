@@ -0,0 +1,87 @@
+// Copyright (C) 2018-2020 Hegemonie's AUTHORS
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package region
+
+import "testing"
+
+func TestCity_Market(t *testing.T) {
+	w := World{}
+	w.Init()
+	w.mapView = &stepMapView{}
+	w.Config.InstantTransfers = true
+
+	r, _ := w.CreateRegion("test", "test")
+	c0, _ := r.CityCreate(1)
+	c0.Stock.Set(ResourcesUniform(10))
+	c1, _ := r.CityCreate(2)
+	c1.Stock.Set(ResourcesUniform(10))
+
+	goods := Resources{0, 0, 5, 0, 0, 0}
+	price := Resources{0, 3, 0, 0, 0, 0}
+
+	if _, err := c0.MarketPost(r, ResourcesUniform(20), price); err != ErrNotEnoughResources {
+		t.Fatal()
+	}
+	o, err := c0.MarketPost(r, goods, price)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c0.Stock[2] != 5 || len(r.Market) != 1 {
+		t.Fatal()
+	}
+	if err = c1.MarketCancel(r, o.ID); err != errForbidden {
+		t.Fatal()
+	}
+	if err = c0.MarketAccept(r, o.ID); err == nil {
+		t.Fatal()
+	}
+	if err = c0.MarketCancel(r, o.ID); err != nil {
+		t.Fatal(err)
+	}
+	if !c0.Stock.Equals(ResourcesUniform(10)) || len(r.Market) != 0 {
+		t.Fatal()
+	}
+
+	// Instant settlement
+	o, _ = c0.MarketPost(r, goods, price)
+	if err = c1.MarketAccept(r, o.ID); err != nil {
+		t.Fatal(err)
+	}
+	if !c0.Stock.Equals(Resources{10, 13, 5, 10, 10, 10}) || !c1.Stock.Equals(Resources{10, 7, 15, 10, 10, 10}) {
+		t.Fatal()
+	}
+
+	// Settlement with transports
+	w.Config.InstantTransfers = false
+	o, _ = c0.MarketPost(r, goods, price)
+	if err = c1.MarketAccept(r, o.ID); err != nil {
+		t.Fatal(err)
+	}
+	if len(c0.Armies) != 1 || len(c1.Armies) != 1 {
+		t.Fatal()
+	}
+	r.Move()
+	if !c0.Stock.Equals(Resources{10, 16, 0, 10, 10, 10}) || !c1.Stock.Equals(Resources{10, 4, 20, 10, 10, 10}) {
+		t.Fatal()
+	}
+
+	// A trade treaty spares the transports
+	c0.Owner, c1.Owner = "a", "b"
+	treaty, _ := r.TreatyPropose("a", "b", TreatyTrade)
+	if err = r.TreatyAccept(treaty.ID, "b"); err != nil {
+		t.Fatal(err)
+	}
+	o, _ = c0.MarketPost(r, Resources{0, 5, 0, 0, 0, 0}, Resources{0, 0, 5, 0, 0, 0})
+	if err = c1.MarketAccept(r, o.ID); err != nil {
+		t.Fatal(err)
+	}
+	if len(c0.Armies) != 0 || len(c1.Armies) != 0 {
+		t.Fatal()
+	}
+	if !c0.Stock.Equals(Resources{10, 11, 5, 10, 10, 10}) || !c1.Stock.Equals(Resources{10, 9, 15, 10, 10, 10}) {
+		t.Fatal()
+	}
+}
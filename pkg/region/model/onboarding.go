@@ -0,0 +1,135 @@
+// Copyright (C) 2018-2020 Hegemonie's AUTHORS
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package region
+
+import "math/rand"
+
+// The strategies to pick the City claimed by a Character joining a Region
+const (
+	// Any free City
+	ClaimRandom = "random"
+	// The free City the farthest from the Cities already owned
+	ClaimFarthest = "farthest"
+	// The free City at the given location
+	ClaimCell = "cell"
+)
+
+// Maximum number of steps walked from the owned Cities to evaluate the
+// distances on the map
+const claimDistanceMax = 1024
+
+// Return the Cities owned by nobody
+func (r *Region) FreeCities() []*City {
+	out := make([]*City, 0)
+	for _, c := range r.Cities {
+		if c.Owner == "" {
+			out = append(out, c)
+		}
+	}
+	return out
+}
+
+// Return the number of steps on the map from the closest of the sources to
+// each location reached within claimDistanceMax steps. The roads are walked
+// breadth-first, from all the sources at once.
+func (r *Region) distances(sources []uint64) map[uint64]uint32 {
+	out := make(map[uint64]uint32)
+	for _, id := range sources {
+		out[id] = 0
+	}
+	front := sources
+	for hops := uint32(1); hops < claimDistanceMax && len(front) > 0; hops++ {
+		next := make([]uint64, 0)
+		for _, id := range front {
+			neighbors, err := r.Neighbors(id)
+			if err != nil {
+				continue
+			}
+			for _, n := range neighbors {
+				if _, ok := out[n]; !ok {
+					out[n] = hops
+					next = append(next, n)
+				}
+			}
+		}
+		front = next
+	}
+	return out
+}
+
+// Return the free City whose closest owned City is the farthest. Without any
+// owned City, a free City is randomly picked.
+func (r *Region) pickFarthest(free []*City) *City {
+	owned := make([]uint64, 0)
+	for _, c := range r.Cities {
+		if c.Owner != "" {
+			owned = append(owned, c.ID)
+		}
+	}
+	if len(owned) <= 0 {
+		return free[rand.Intn(len(free))]
+	}
+
+	dist := r.distances(owned)
+	var best *City
+	var bestDist uint32
+	for _, c := range free {
+		d, ok := dist[c.ID]
+		if !ok {
+			d = claimDistanceMax
+		}
+		if best == nil || d > bestDist {
+			best, bestDist = c, d
+		}
+	}
+	return best
+}
+
+// Give a free City to a Character who doesn't own any City in the Region yet.
+// The City is picked according to the strategy, or the strategy configured in
+// the World if none is given. The cell is only used by the ClaimCell strategy.
+func (r *Region) ClaimCity(char, strategy string, cell uint64) (*City, error) {
+	if char == "" {
+		return nil, errInvalidCharacter
+	}
+	for _, c := range r.Cities {
+		if c.Owner == char {
+			return nil, errAlreadyOwner
+		}
+	}
+	if strategy == "" {
+		strategy = r.world.Config.OnboardingStrategy
+	}
+
+	var city *City
+	free := r.FreeCities()
+	switch strategy {
+	case ClaimCell:
+		city = r.CityGet(cell)
+		if city == nil {
+			return nil, errCityNotFound
+		}
+		if city.Owner != "" {
+			return nil, errForbidden
+		}
+	case ClaimFarthest:
+		if len(free) <= 0 {
+			return nil, errCityNotFound
+		}
+		city = r.pickFarthest(free)
+	case ClaimRandom, "":
+		if len(free) <= 0 {
+			return nil, errCityNotFound
+		}
+		city = free[rand.Intn(len(free))]
+	default:
+		return nil, errInvalidStrategy
+	}
+
+	city.Owner = char
+	r.world.notifier.City(char).Item(city).Owner("", char).Send()
+	return city, nil
+}
@@ -7,6 +7,8 @@ package region
 
 import (
 	"errors"
+	"fmt"
+	"os"
 	"sort"
 
 	"github.com/jfsmig/hegemonie/pkg/utils"
@@ -16,6 +18,10 @@ func (w *World) SetNotifier(n Notifier) {
 	w.notifier = LogEvent(n)
 }
 
+func (w *World) SetMapView(m MapView) {
+	w.mapView = m
+}
+
 func (w *World) Init() {
 	w.WLock()
 	defer w.WUnlock()
@@ -25,6 +31,7 @@ func (w *World) Init() {
 	w.Definitions.Units = make(SetOfUnitTypes, 0)
 	w.Definitions.Buildings = make(SetOfBuildingTypes, 0)
 	w.Definitions.Knowledges = make(SetOfKnowledgeTypes, 0)
+	w.Definitions.Artifacts = make(SetOfArtifactTypes, 0)
 }
 
 func (w *World) Check() error {
@@ -33,6 +40,9 @@ func (w *World) Check() error {
 	if w.notifier == nil || w.mapView == nil {
 		return errInvalidState
 	}
+	if err := w.Config.Check(); err != nil {
+		return err
+	}
 	if err := w.Definitions.Check(); err != nil {
 		return err
 	}
@@ -44,6 +54,28 @@ func (w *World) Check() error {
 	return nil
 }
 
+func (c *Configuration) Check() error {
+	ratios := []struct {
+		name  string
+		value float64
+	}{
+		{"flea penalty", c.FleaPenalty},
+		{"epidemic outbreak rate", c.EpidemicOutbreakRate},
+		{"epidemic spread rate", c.EpidemicSpreadRate},
+		{"epidemic production impact", c.EpidemicProdImpact},
+		{"epidemic unit impact", c.EpidemicUnitImpact},
+		{"starvation impact", c.StarvationImpact},
+		{"heal rate", c.HealRate},
+		{"cancel refund", c.CancelRefund},
+	}
+	for _, r := range ratios {
+		if r.value < 0 || r.value > 1 {
+			return fmt.Errorf("config: %s out of [0,1]", r.name)
+		}
+	}
+	return nil
+}
+
 func (d *DefinitionsBase) Check() error {
 	if !sort.IsSorted(&d.Knowledges) {
 		return errors.New("knowledge types unsorted")
@@ -54,7 +86,13 @@ func (d *DefinitionsBase) Check() error {
 	if !sort.IsSorted(&d.Units) {
 		return errors.New("unit types unsorted")
 	}
+	if !sort.IsSorted(&d.Artifacts) {
+		return errors.New("artifact types unsorted")
+	}
 
+	if errs := d.Validate(); len(errs) > 0 {
+		return errs
+	}
 	return nil
 }
 
@@ -65,6 +103,12 @@ func (w *Region) Check() error {
 	if !sort.IsSorted(&w.Fights) {
 		return errors.New("fights unsorted")
 	}
+	if !sort.IsSorted(&w.Treaties) {
+		return errors.New("treaties unsorted")
+	}
+	if !sort.IsSorted(&w.Market) {
+		return errors.New("market unsorted")
+	}
 
 	for _, a := range w.Fights {
 		if !sort.IsSorted(&a.Attack) {
@@ -104,6 +148,7 @@ func (d *DefinitionsBase) PostLoad() error {
 	sort.Sort(&d.Knowledges)
 	sort.Sort(&d.Buildings)
 	sort.Sort(&d.Units)
+	sort.Sort(&d.Artifacts)
 	return nil
 }
 
@@ -111,11 +156,14 @@ func (r *Region) PostLoad() error {
 	// Sort all the lookup arrays
 	sort.Sort(&r.Cities)
 	sort.Sort(&r.Fights)
+	sort.Sort(&r.Treaties)
+	sort.Sort(&r.Market)
 
 	for _, c := range r.Cities {
 		sort.Sort(&c.Knowledges)
 		sort.Sort(&c.Buildings)
 		sort.Sort(&c.Units)
+		sort.Sort(&c.Artifacts)
 		if c.Armies == nil {
 			c.Armies = make(SetOfArmies, 0)
 		} else {
@@ -130,7 +178,44 @@ func (r *Region) PostLoad() error {
 		for _, a := range c.Armies {
 			// Link Armies to their City
 			a.City = c
-			// FIXME: Link each Army to its Fight
+			sort.Sort(&a.Artifacts)
+		}
+	}
+
+	// Link each Fight to the Armies involved, so that the Army loaded in
+	// the City and the Army loaded in the Fight are the same object
+	armies := make(map[string]*Army)
+	for _, c := range r.Cities {
+		for _, a := range c.Armies {
+			armies[a.ID] = a
+		}
+	}
+	relink := func(side SetOfArmies) SetOfArmies {
+		out := make(SetOfArmies, 0, len(side))
+		for _, a := range side {
+			if pa, ok := armies[a.ID]; ok {
+				out = append(out, pa)
+			}
+		}
+		sort.Sort(&out)
+		return out
+	}
+	for _, f := range r.Fights {
+		f.Attack = relink(f.Attack)
+		f.Defense = relink(f.Defense)
+	}
+	// Link each City to its Overlord
+	for _, c := range r.Cities {
+		if c.Overlord != 0 {
+			if c.pOverlord = r.CityGet(c.Overlord); c.pOverlord != nil {
+				c.pOverlord.lieges.Add(c)
+			}
+		}
+	}
+
+	for _, c := range r.Cities {
+		if c.Assault != nil {
+			c.Assault = r.Fights.Get(c.Assault.ID)
 		}
 	}
 
@@ -151,9 +236,10 @@ func (d *DefinitionsBase) Sections(p string) utils.PersistencyMapping {
 		panic("Invalid path")
 	}
 	return []utils.CfgSection{
-		{p + "/units.json", &d.Units},
-		{p + "/buildings.json", &d.Buildings},
-		{p + "/knowledge.json", &d.Knowledges},
+		{Path: p + "/units.json", Obj: &d.Units},
+		{Path: p + "/buildings.json", Obj: &d.Buildings},
+		{Path: p + "/knowledge.json", Obj: &d.Knowledges},
+		{Path: p + "/artifacts.json", Obj: &d.Artifacts, Optional: true},
 	}
 }
 
@@ -162,8 +248,10 @@ func (r *Region) Sections(p string) utils.PersistencyMapping {
 		panic("Invalid path")
 	}
 	return []utils.CfgSection{
-		{p + "/cities.json", &r.Cities},
-		{p + "/fights.json", &r.Fights},
+		{Path: p + "/cities.json", Obj: &r.Cities},
+		{Path: p + "/fights.json", Obj: &r.Fights},
+		{Path: p + "/treaties.json", Obj: &r.Treaties, Optional: true},
+		{Path: p + "/market.json", Obj: &r.Market, Optional: true},
 	}
 }
 
@@ -172,7 +260,7 @@ func (w *World) Sections(p string) utils.PersistencyMapping {
 		panic("Invalid path")
 	}
 	sections := []utils.CfgSection{
-		{p + "/config.json", &w.Config},
+		{Path: p + "/config.json", Obj: &w.Config},
 	}
 	sections = append(sections, w.Definitions.Sections(p+"/_defs")...)
 	for _, r := range w.Regions {
@@ -180,3 +268,83 @@ func (w *World) Sections(p string) utils.PersistencyMapping {
 	}
 	return sections
 }
+
+// The reference to a Region in the index of the Regions of a World
+type regionRef struct {
+	Name     string
+	MapName  string
+	Schedule *Schedule `json:",omitempty"`
+}
+
+// Save the Region in its own directory, under the given path, then update
+// the index of the Regions of the World, that also holds their Schedule.
+func (w *World) SaveRegion(p string, r *Region) error {
+	snap, err := w.SnapshotRegion(p, r)
+	if err != nil {
+		return err
+	}
+	return snap.Write()
+}
+
+// A serialised copy of a Region and of the index of the World, that can be
+// written on disk without any lock held on the World.
+type RegionSnapshot struct {
+	dir      string
+	sections utils.PersistencyMapping
+}
+
+// Serialise the Region and the index of the World under the given path.
+// The World must be locked by the caller.
+func (w *World) SnapshotRegion(p string, r *Region) (*RegionSnapshot, error) {
+	if p == "" {
+		panic("Invalid path")
+	}
+
+	index := make([]regionRef, 0, len(w.Regions))
+	for _, x := range w.Regions {
+		index = append(index, regionRef{Name: x.Name, MapName: x.MapName, Schedule: &x.Schedule})
+	}
+	sections := append(r.Sections(p+"/"+r.Name), utils.CfgSection{Path: p + "/regions.json", Obj: &index})
+	snap, err := sections.Snapshot()
+	if err != nil {
+		return nil, err
+	}
+	return &RegionSnapshot{dir: p + "/" + r.Name, sections: snap}, nil
+}
+
+// Write the snapshot on disk, the directory of the Region is created if needed
+func (s *RegionSnapshot) Write() error {
+	if err := os.MkdirAll(s.dir, 0755); err != nil {
+		return err
+	}
+	return s.sections.Dump()
+}
+
+// Load the Regions listed in the index of the World, under the given path.
+// A missing index means the World has no Region yet.
+func (w *World) LoadRegions(p string) error {
+	if p == "" {
+		panic("Invalid path")
+	}
+	if _, err := os.Stat(p + "/regions.json"); os.IsNotExist(err) {
+		return nil
+	}
+
+	index := make([]regionRef, 0)
+	if err := (utils.PersistencyMapping{{Path: p + "/regions.json", Obj: &index}}).Load(); err != nil {
+		return err
+	}
+	for _, ref := range index {
+		r, err := w.CreateRegion(ref.Name, ref.MapName)
+		if err != nil {
+			return err
+		}
+		if err = r.Sections(p + "/" + r.Name).Load(); err != nil {
+			return err
+		}
+		if ref.Schedule != nil {
+			r.Schedule = *ref.Schedule
+		}
+	}
+	return nil
+}
@@ -0,0 +1,57 @@
+// Copyright (C) 2018-2020 Hegemonie's AUTHORS
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package region
+
+// Maximum number of changes kept in the popularity history of a City.
+// The oldest changes are forgotten first.
+const popularityHistoryMax = 64
+
+// The reasons of a permanent change of the Popularity of a City.
+const (
+	PopReasonBuild       = "Build"
+	PopReasonTrain       = "Train"
+	PopReasonLearn       = "Learn"
+	PopReasonFall        = "Fall"
+	PopReasonDestroy     = "Destroy"
+	PopReasonDismantle   = "Dismantle"
+	PopReasonDeath       = "Death"
+	PopReasonKill        = "Kill"
+	PopReasonDisband     = "Disband"
+	PopReasonArmyCreate  = "ArmyCreate"
+	PopReasonArmyDisband = "ArmyDisband"
+	PopReasonArmyFlip    = "ArmyFlip"
+	PopReasonSpyCaught   = "SpyCaught"
+	PopReasonStealActor  = "StealActor"
+	PopReasonStealVictim = "StealVictim"
+)
+
+// PopularityChange records one permanent change of the Popularity of a City
+type PopularityChange struct {
+	// Why the Popularity changed, one of the PopReason* constants
+	Reason string
+
+	// The type of the item that caused the change (a UnitType, a BuildingType,
+	// a KnowledgeType), or 0 when it is not relevant.
+	Type uint64 `json:",omitempty"`
+
+	// The value added to the permanent Popularity
+	Delta int64
+}
+
+// Apply a permanent change to the Popularity of the City and record it in
+// the history of the City. Null changes are ignored.
+func (c *City) ChangePopularity(reason string, typeID uint64, delta int64) {
+	if delta == 0 {
+		return
+	}
+	c.PermanentPopularity += delta
+	c.PopularityHistory = append(c.PopularityHistory, PopularityChange{
+		Reason: reason, Type: typeID, Delta: delta,
+	})
+	if excess := len(c.PopularityHistory) - popularityHistoryMax; excess > 0 {
+		c.PopularityHistory = append(c.PopularityHistory[:0], c.PopularityHistory[excess:]...)
+	}
+}
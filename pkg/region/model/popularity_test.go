@@ -0,0 +1,82 @@
+// Copyright (C) 2018-2020 Hegemonie's AUTHORS
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package region
+
+import (
+	"testing"
+)
+
+func TestCity_ChangePopularity(t *testing.T) {
+	c := MakeCity()
+	c.ChangePopularity(PopReasonBuild, 1, 0)
+	if len(c.PopularityHistory) != 0 {
+		t.Fatal()
+	}
+	for i := 0; i < popularityHistoryMax+2; i++ {
+		c.ChangePopularity(PopReasonBuild, uint64(i), 1)
+	}
+	if c.PermanentPopularity != popularityHistoryMax+2 {
+		t.Fatal(c.PermanentPopularity)
+	}
+	if len(c.PopularityHistory) != popularityHistoryMax || c.PopularityHistory[0].Type != 2 {
+		t.Fatal()
+	}
+}
+
+func TestCity_PopularityLifecycle(t *testing.T) {
+	w := World{}
+	w.Init()
+	w.Config.PopBonusArmyCreate = -1
+	w.Config.PopBonusArmyDisband = 1
+	w.Definitions.Units.Add(&UnitType{
+		ID: 1, Health: 10, Attack: 100, Prod: ResourceModifierNoop(),
+		PopBonusTrain: 2,
+	})
+	w.Definitions.Units.Add(&UnitType{
+		ID: 2, Health: 10, Prod: ResourceModifierNoop(),
+		PopBonusDeath: -3, PopBonusKill: 5,
+	})
+	w.Definitions.Buildings.Add(&BuildingType{
+		ID: 1, Stock: ResourceModifierNoop(), Prod: ResourceModifierNoop(),
+		PopBonusBuild: 7,
+	})
+
+	r, _ := w.CreateRegion("test", "test")
+	c0, _ := r.CityCreate(1)
+	c1, _ := r.CityCreate(2)
+	c0.StockCapacity.Set(ResourcesUniform(100))
+
+	// Achievements
+	c0.Units.Add(&Unit{ID: "u0", Type: 1, Health: 10, Ticks: 1})
+	c0.Buildings.Add(&Building{ID: "b0", Type: 1, Ticks: 1})
+	c0.Produce(r)
+	if c0.PermanentPopularity != 9 || len(c0.PopularityHistory) != 2 {
+		t.Fatal(c0.PermanentPopularity)
+	}
+
+	// Army lifecycle
+	a, err := c0.CreateArmyFromIds(r, "u0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c0.PermanentPopularity != 8 {
+		t.Fatal(c0.PermanentPopularity)
+	}
+
+	// Fight: the defender dies and the attacker gets the kill
+	c1.Units.Add(&Unit{ID: "d0", Type: 2, Health: 10})
+	a.Cell = c1.ID
+	if !a.JoinCityAttack(r, c1) {
+		t.Fatal()
+	}
+	r.Fights[0].Round(r)
+	if c1.PermanentPopularity != -1-3 {
+		t.Fatal(c1.PermanentPopularity)
+	}
+	if c0.PermanentPopularity != 8+5 {
+		t.Fatal(c0.PermanentPopularity)
+	}
+}
@@ -0,0 +1,215 @@
+// Copyright (C) 2018-2020 Hegemonie's AUTHORS
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package region
+
+import "errors"
+
+// The categories of items a City may have in its production queue
+const (
+	QueueUnits = iota
+	QueueBuildings
+	QueueKnowledges
+	QueueMax
+)
+
+// ProductionQueue tells how a City spends the resources left in its Stock
+// after the production, on the items still in progress.
+type ProductionQueue struct {
+	// IDs of the items in progress, in the order of priority. The items
+	// absent from that list come after, Units first, then Buildings and
+	// eventually Knowledges.
+	Order []string `json:",omitempty"`
+
+	// IDs of the items in progress that receive no resources
+	Paused []string `json:",omitempty"`
+
+	// Ratio of the leftover Stock that each category of items may spend at
+	// each production round, indexed by the Queue* constants.
+	// A null value means no limit.
+	Budget [QueueMax]float64
+}
+
+// An item in progress in the City
+type pendingItem struct {
+	id       string
+	category int
+	ticks    *uint32
+	paid     *Resources
+	cost     Resources
+	done     func()
+}
+
+// Return the items of the City still in progress, in the default order
+func (c *City) pendingItems(w *World) []pendingItem {
+	out := make([]pendingItem, 0)
+	for _, u := range c.Units {
+		if ut := w.UnitTypeGet(u.Type); ut != nil && u.Ticks > 0 {
+			out = append(out, pendingItem{
+				id: u.ID, category: QueueUnits, ticks: &u.Ticks, paid: &u.Paid, cost: ut.Cost,
+				done: func() { c.ChangePopularity(PopReasonTrain, ut.ID, ut.PopBonusTrain) },
+			})
+		}
+	}
+	for _, b := range c.Buildings {
+		if bt := w.BuildingTypeGet(b.Type); bt != nil && b.Ticks > 0 {
+			out = append(out, pendingItem{
+				id: b.ID, category: QueueBuildings, ticks: &b.Ticks, paid: &b.Paid, cost: bt.Cost,
+				done: func() { c.ChangePopularity(PopReasonBuild, bt.ID, bt.PopBonusBuild) },
+			})
+		}
+	}
+	for _, k := range c.Knowledges {
+		if kt := w.KnowledgeTypeGet(k.Type); kt != nil && k.Ticks > 0 {
+			out = append(out, pendingItem{
+				id: k.ID, category: QueueKnowledges, ticks: &k.Ticks, paid: &k.Paid, cost: kt.Cost,
+				done: func() { c.ChangePopularity(PopReasonLearn, kt.ID, kt.PopBonusLearn) },
+			})
+		}
+	}
+	return out
+}
+
+func (c *City) isPending(w *World, id string) bool {
+	for _, item := range c.pendingItems(w) {
+		if item.id == id {
+			return true
+		}
+	}
+	return false
+}
+
+func (q *ProductionQueue) isPaused(id string) bool {
+	for _, x := range q.Paused {
+		if x == id {
+			return true
+		}
+	}
+	return false
+}
+
+// Forget the items that are not in progress anymore
+func (q *ProductionQueue) prune(pending []pendingItem) {
+	alive := make(map[string]bool)
+	for _, item := range pending {
+		alive[item.id] = true
+	}
+	filter := func(ids []string) []string {
+		out := ids[:0]
+		for _, id := range ids {
+			if alive[id] {
+				out = append(out, id)
+			}
+		}
+		return out
+	}
+	q.Order = filter(q.Order)
+	q.Paused = filter(q.Paused)
+}
+
+// Spend the leftover Stock on the items in progress, following the order of
+// the production queue. Each item progresses by at most one tick per round,
+// provided the Stock holds its cost and the budget of its category allows it.
+func (c *City) Evolve(w *Region) {
+	pending := c.pendingItems(w.world)
+	c.Queue.prune(pending)
+
+	rank := make(map[string]int)
+	for i, id := range c.Queue.Order {
+		rank[id] = i
+	}
+	ordered := make([]pendingItem, 0, len(pending))
+	for _, id := range c.Queue.Order {
+		for _, item := range pending {
+			if item.id == id {
+				ordered = append(ordered, item)
+			}
+		}
+	}
+	for _, item := range pending {
+		if _, ok := rank[item.id]; !ok {
+			ordered = append(ordered, item)
+		}
+	}
+
+	var budget, spent [QueueMax]Resources
+	for i, share := range c.Queue.Budget {
+		budget[i] = c.Stock
+		if share > 0 {
+			budget[i].Multiply(MultiplierUniform(share))
+		}
+	}
+
+	for _, item := range ordered {
+		if c.Queue.isPaused(item.id) || !c.Stock.GreaterOrEqualTo(item.cost) {
+			continue
+		}
+		total := spent[item.category]
+		total.Add(item.cost)
+		if !budget[item.category].GreaterOrEqualTo(total) {
+			continue
+		}
+		spent[item.category] = total
+		c.Stock.Remove(item.cost)
+		item.paid.Add(item.cost)
+		*item.ticks--
+		if *item.ticks <= 0 {
+			item.done()
+			// FIXME(jfs): Notify the City
+		}
+	}
+}
+
+// Set the priority of the items in progress. The listed items come first,
+// in the given order.
+func (c *City) SetQueueOrder(w *Region, ids ...string) error {
+	order := make([]string, 0, len(ids))
+	seen := make(map[string]bool)
+	for _, id := range ids {
+		if seen[id] {
+			continue
+		}
+		if !c.isPending(w.world, id) {
+			return ErrNoSuchItem
+		}
+		seen[id] = true
+		order = append(order, id)
+	}
+	c.Queue.Order = order
+	return nil
+}
+
+// Pause or resume an item in progress
+func (c *City) PauseItem(w *Region, id string, paused bool) error {
+	if !c.isPending(w.world, id) {
+		return ErrNoSuchItem
+	}
+	if paused == c.Queue.isPaused(id) {
+		return nil
+	}
+	if paused {
+		c.Queue.Paused = append(c.Queue.Paused, id)
+	} else {
+		out := c.Queue.Paused[:0]
+		for _, x := range c.Queue.Paused {
+			if x != id {
+				out = append(out, x)
+			}
+		}
+		c.Queue.Paused = out
+	}
+	return nil
+}
+
+// Set the ratio of the leftover Stock each category of items may spend
+func (c *City) SetQueueBudget(budget [QueueMax]float64) error {
+	for _, share := range budget {
+		if share < 0 || share > 1 {
+			return errors.New("Invalid budget share")
+		}
+	}
+	c.Queue.Budget = budget
+	return nil
+}
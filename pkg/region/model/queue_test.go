@@ -0,0 +1,84 @@
+// Copyright (C) 2018-2020 Hegemonie's AUTHORS
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package region
+
+import (
+	"testing"
+)
+
+func TestCity_ProductionQueue(t *testing.T) {
+	w := World{}
+	w.Init()
+	w.Definitions.Units.Add(&UnitType{ID: 1, Cost: ResourcesUniform(10), Prod: ResourceModifierNoop()})
+	w.Definitions.Buildings.Add(&BuildingType{
+		ID: 1, Cost: ResourcesUniform(10), Stock: ResourceModifierNoop(), Prod: ResourceModifierNoop(),
+	})
+	w.Definitions.Knowledges.Add(&KnowledgeType{
+		ID: 1, Cost: ResourcesUniform(10), Stock: ResourceModifierNoop(), Prod: ResourceModifierNoop(),
+	})
+
+	r, _ := w.CreateRegion("test", "test")
+	c, _ := r.CityCreate(1)
+	c.Units.Add(&Unit{ID: "u0", Type: 1, Ticks: 5})
+	c.Buildings.Add(&Building{ID: "b0", Type: 1, Ticks: 5})
+	c.Knowledges.Add(&Knowledge{ID: "k0", Type: 1, Ticks: 5})
+
+	if err := c.SetQueueOrder(r, "k0", "nope"); err != ErrNoSuchItem {
+		t.Fatal(err)
+	}
+	if err := c.PauseItem(r, "nope", true); err != ErrNoSuchItem {
+		t.Fatal(err)
+	}
+	if err := c.SetQueueBudget([QueueMax]float64{1.5, 0, 0}); err == nil {
+		t.Fatal()
+	}
+
+	// Default order: Units first
+	c.Stock.Set(ResourcesUniform(15))
+	c.Evolve(r)
+	if c.Units[0].Ticks != 4 || c.Buildings[0].Ticks != 5 || c.Knowledges[0].Ticks != 5 {
+		t.Fatal()
+	}
+	if !c.Units[0].Paid.Equals(ResourcesUniform(10)) || !c.Buildings[0].Paid.IsZero() {
+		t.Fatal()
+	}
+
+	// Knowledge first, the Unit is paused
+	if err := c.SetQueueOrder(r, "k0", "k0", "b0"); err != nil || len(c.Queue.Order) != 2 {
+		t.Fatal(err)
+	}
+	if err := c.PauseItem(r, "u0", true); err != nil {
+		t.Fatal(err)
+	}
+	c.Stock.Set(ResourcesUniform(30))
+	c.Evolve(r)
+	if c.Units[0].Ticks != 4 || c.Buildings[0].Ticks != 4 || c.Knowledges[0].Ticks != 4 {
+		t.Fatal()
+	}
+
+	// The Knowledge may spend only a third of the leftover
+	if err := c.SetQueueBudget([QueueMax]float64{0, 0, 0.3}); err != nil {
+		t.Fatal(err)
+	}
+	c.Stock.Set(ResourcesUniform(30))
+	c.Evolve(r)
+	if c.Buildings[0].Ticks != 3 || c.Knowledges[0].Ticks != 4 {
+		t.Fatal()
+	}
+
+	// The achieved items leave the queue
+	if err := c.PauseItem(r, "u0", false); err != nil || len(c.Queue.Paused) != 0 {
+		t.Fatal(err)
+	}
+	c.Knowledges[0].Ticks = 1
+	c.Queue.Budget = [QueueMax]float64{}
+	c.Stock.Set(ResourcesUniform(10))
+	c.Evolve(r)
+	c.Evolve(r)
+	if c.Knowledges[0].Ticks != 0 || len(c.Queue.Order) != 1 {
+		t.Fatal(c.Queue.Order)
+	}
+}
@@ -5,18 +5,112 @@
 
 package region
 
+import (
+	"github.com/google/uuid"
+	"math/rand"
+)
+
 func (r *Region) Produce() {
 	for _, c := range r.Cities {
 		c.Produce(r)
 	}
+	r.Epidemic()
+	r.Schedule.ProduceTicks++
 }
 
 func (r *Region) Move() {
 	for _, c := range r.Cities {
-		for _, a := range c.Armies {
+		// Iterate on a copy, because the Armies may disband while moving
+		for _, a := range append(SetOfArmies{}, c.Armies...) {
 			a.Move(r)
 		}
 	}
+	r.Fight()
+	r.Schedule.MoveTicks++
+}
+
+// Play one round of each Fight happening in the Region, and terminate the
+// Fights that have a winner.
+func (r *Region) Fight() {
+	for _, f := range append(SetOfFights{}, r.Fights...) {
+		if f.Round(r) {
+			f.End(r)
+		}
+	}
+}
+
+// Return the next step on the path from src to dst, on the map of the Region.
+// The destination is already reached when it is the source, the map isn't
+// even asked.
+func (r *Region) Step(src, dst uint64) (uint64, error) {
+	if src == dst {
+		return dst, nil
+	}
+	return r.world.mapView.Step(r.MapName, src, dst)
+}
+
+// Return the locations directly reachable from id, on the map of the Region
+func (r *Region) Neighbors(id uint64) ([]uint64, error) {
+	return r.world.mapView.Neighbors(r.MapName, id)
+}
+
+// Maximum number of steps walked along a road to find the next City
+const roadStepsMax = 1024
+
+// Return the Cities reachable from the given location along the roads,
+// without going through any other City. The roads are walked breadth-first.
+func (r *Region) NeighborCities(id uint64) ([]*City, error) {
+	out := make([]*City, 0)
+	seen := map[uint64]bool{id: true}
+	front := []uint64{id}
+	for hops := 0; hops < roadStepsMax && len(front) > 0; hops++ {
+		next := make([]uint64, 0)
+		for _, loc := range front {
+			neighbors, err := r.Neighbors(loc)
+			if err != nil {
+				return nil, err
+			}
+			for _, n := range neighbors {
+				if seen[n] {
+					continue
+				}
+				seen[n] = true
+				if c := r.CityGetAt(n); c != nil {
+					out = append(out, c)
+				} else {
+					next = append(next, n)
+				}
+			}
+		}
+		front = next
+	}
+	return out, nil
+}
+
+// Return all the armies standing at the given location, whatever the City
+// that controls them.
+func (r *Region) ArmiesAt(loc uint64) []*Army {
+	out := make([]*Army, 0)
+	for _, c := range r.Cities {
+		for _, a := range c.Armies {
+			if a.Cell == loc {
+				out = append(out, a)
+			}
+		}
+	}
+	return out
+}
+
+// Create an empty Fight at the given location and register it in the Region
+func (r *Region) fightCreate(loc uint64) *Fight {
+	f := &Fight{
+		ID:      uuid.New().String(),
+		Cell:    loc,
+		Defense: make(SetOfArmies, 0),
+		Attack:  make(SetOfArmies, 0),
+	}
+	r.Fights.Add(f)
+	return f
 }
 
 func (r *Region) CityGet(id uint64) *City {
@@ -45,6 +139,16 @@ func (r *Region) CityCreate(loc uint64) (*City, error) {
 	return r.CityCreateModel(loc, nil)
 }
 
+// Create a City after a pattern randomly picked among the CityPatterns of
+// the configuration, or a blank City if no pattern is configured.
+func (r *Region) CityCreateRandom(loc uint64) (*City, error) {
+	var model *City
+	if patterns := r.world.Config.CityPatterns; len(patterns) > 0 {
+		model = &patterns[rand.Intn(len(patterns))]
+	}
+	return r.CityCreateModel(loc, model)
+}
+
 func (r *Region) CityGetAndCheck(cityID uint64, charID string) (*City, error) {
 	// Fetch + sanity checks about the city
 	pCity := r.CityGet(cityID)
@@ -0,0 +1,172 @@
+// Copyright (C) 2018-2020 Hegemonie's AUTHORS
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package region
+
+import (
+	"testing"
+	"time"
+)
+
+func TestWorld_CreateRegionFromSites(t *testing.T) {
+	w := World{}
+	w.Init()
+	w.Config.CityPatterns = []City{
+		{Stock: ResourcesUniform(5), Production: ResourcesUniform(7)},
+	}
+
+	sites := []CitySite{{ID: 1, Name: "a"}, {ID: 4, Name: "b"}, {ID: 1, Name: "c"}}
+	if _, err := w.CreateRegionFromSites("r", "m", sites); err == nil || len(w.Regions) != 0 {
+		t.Fatal()
+	}
+
+	r, err := w.CreateRegionFromSites("r", "m", sites[:2])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if w.Regions.Get("r") != r || len(r.Cities) != 2 {
+		t.Fatal()
+	}
+	if c := r.CityGet(4); c == nil || c.Name != "b" || !c.Production.Equals(ResourcesUniform(7)) {
+		t.Fatal()
+	}
+	if _, err = w.CreateRegionFromSites("r", "m", nil); err != errRegionExists {
+		t.Fatal(err)
+	}
+
+	// The saved Region is loaded back
+	dir := t.TempDir()
+	if err = w.SaveRegion(dir, r); err != nil {
+		t.Fatal(err)
+	}
+	w1 := World{}
+	w1.Init()
+	if err = w1.LoadRegions(dir); err != nil {
+		t.Fatal(err)
+	}
+	if r1 := w1.Regions.Get("r"); r1 == nil || r1.MapName != "m" || len(r1.Cities) != 2 {
+		t.Fatal()
+	}
+}
+
+// A map where the cells are aligned and numbered in order
+type lineMapView struct{}
+
+func (m *lineMapView) Step(mapName string, src, dst uint64) (uint64, error) {
+	if src < dst {
+		return src + 1, nil
+	}
+	return src - 1, nil
+}
+
+func (m *lineMapView) Neighbors(mapName string, id uint64) ([]uint64, error) {
+	return []uint64{id - 1, id + 1}, nil
+}
+
+func TestRegion_ClaimCity(t *testing.T) {
+	w := World{}
+	w.Init()
+	w.mapView = &lineMapView{}
+	sites := []CitySite{{ID: 1}, {ID: 2}, {ID: 5}, {ID: 9}}
+	r, err := w.CreateRegionFromSites("r", "m", sites)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err = r.ClaimCity("a", ClaimCell, 3); err != errCityNotFound {
+		t.Fatal(err)
+	}
+	c, err := r.ClaimCity("a", ClaimCell, 2)
+	if err != nil || c.ID != 2 || c.Owner != "a" {
+		t.Fatal(err)
+	}
+	if _, err = r.ClaimCity("a", ClaimRandom, 0); err != errAlreadyOwner {
+		t.Fatal(err)
+	}
+	if _, err = r.ClaimCity("b", ClaimCell, 2); err != errForbidden {
+		t.Fatal(err)
+	}
+	if _, err = r.ClaimCity("b", "nope", 0); err != errInvalidStrategy {
+		t.Fatal(err)
+	}
+
+	// The configured strategy applies by default
+	w.Config.OnboardingStrategy = ClaimFarthest
+	if c, err = r.ClaimCity("b", "", 0); err != nil || c.ID != 9 {
+		t.Fatal(err)
+	}
+	if c, err = r.ClaimCity("c", ClaimFarthest, 0); err != nil || c.ID != 5 {
+		t.Fatal(err)
+	}
+	if c, err = r.ClaimCity("d", ClaimRandom, 0); err != nil || c.ID != 1 {
+		t.Fatal(err)
+	}
+	if _, err = r.ClaimCity("e", ClaimRandom, 0); err != errCityNotFound {
+		t.Fatal(err)
+	}
+	if len(r.FreeCities()) != 0 {
+		t.Fatal()
+	}
+}
+
+func TestRegion_Tick(t *testing.T) {
+	w := World{}
+	w.Init()
+	w.mapView = &stepMapView{}
+	w.Config.MovePeriod = 60
+	w.Config.ProducePeriod = 600
+	r, err := w.CreateRegion("r", "m")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t0 := time.Unix(1000000, 0)
+	if !r.Tick(t0) || r.Schedule.MoveTicks != 1 || r.Schedule.ProduceTicks != 1 {
+		t.Fatal()
+	}
+	if r.Tick(t0.Add(59 * time.Second)) {
+		t.Fatal()
+	}
+	if !r.Tick(t0.Add(60*time.Second)) || r.Schedule.MoveTicks != 2 || r.Schedule.ProduceTicks != 1 {
+		t.Fatal()
+	}
+
+	// The rounds missed during a pause are not played again
+	r.PauseSchedule(true)
+	if r.Tick(t0.Add(time.Hour)) {
+		t.Fatal()
+	}
+	r.PauseSchedule(false)
+	if !r.Tick(t0.Add(time.Hour)) || r.Schedule.MoveTicks != 3 || r.Schedule.ProduceTicks != 2 {
+		t.Fatal()
+	}
+
+	// A manual round is counted, a null period disables the automatic rounds
+	r.SetSchedule(0, 600)
+	r.Move()
+	if !r.Tick(t0.Add(2*time.Hour)) || r.Schedule.MoveTicks != 4 || r.Schedule.ProduceTicks != 3 {
+		t.Fatal()
+	}
+
+	// The Schedule survives a restart, as it was when the snapshot was taken
+	dir := t.TempDir()
+	snap, err := w.SnapshotRegion(dir, r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	saved := r.Schedule
+	r.Move()
+	if err = snap.Write(); err != nil {
+		t.Fatal(err)
+	}
+	w1 := World{}
+	w1.Init()
+	if err = w1.LoadRegions(dir); err != nil {
+		t.Fatal(err)
+	}
+	if r1 := w1.Regions.Get("r"); r1 == nil || r1.Schedule != saved {
+		t.Fatal()
+	}
+}
@@ -91,11 +91,12 @@ func (r *Resources) Multiply(rm ResourcesMultiplier) {
 
 func (r *Resources) Increment(ri ResourcesIncrement) {
 	for i := 0; i < ResourceMax; i++ {
-		post := r[i] + uint64(ri[i])
-		if post > r[i] {
+		if ri[i] >= 0 {
+			r[i] += uint64(ri[i])
+		} else if uint64(-ri[i]) > r[i] {
 			r[i] = 0
 		} else {
-			r[i] = post
+			r[i] -= uint64(-ri[i])
 		}
 	}
 }
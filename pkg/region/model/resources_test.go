@@ -99,3 +99,16 @@ func TestModifiers(t *testing.T) {
 		}
 	}
 }
+
+func TestResources_Increment(t *testing.T) {
+	r := ResourcesUniform(5)
+	r.Increment(IncrementUniform(-7))
+	if !r.IsZero() {
+		t.Fatal(r)
+	}
+	r.Increment(IncrementUniform(3))
+	r.Increment(IncrementUniform(-1))
+	if !r.Equals(ResourcesUniform(2)) {
+		t.Fatal(r)
+	}
+}
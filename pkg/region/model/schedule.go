@@ -0,0 +1,70 @@
+// Copyright (C) 2018-2020 Hegemonie's AUTHORS
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package region
+
+import "time"
+
+// Schedule tells when the rounds of a Region are automatically played
+type Schedule struct {
+	// Period between two movement rounds, in seconds.
+	// A null value disables the automatic movement rounds.
+	MovePeriod uint32
+
+	// Period between two production rounds, in seconds.
+	// A null value disables the automatic production rounds.
+	ProducePeriod uint32
+
+	// No round is automatically played while the Schedule is paused.
+	// The rounds may still be triggered by hand.
+	Paused bool
+
+	// How many rounds have been played since the creation of the Region,
+	// whatever triggered them.
+	MoveTicks    uint64
+	ProduceTicks uint64
+
+	// UNIX timestamps of the last rounds played
+	LastMove    int64
+	LastProduce int64
+}
+
+func isDue(period uint32, last int64, now time.Time) bool {
+	return period > 0 && now.Unix()-last >= int64(period)
+}
+
+// Play the rounds of the Region that are due at the given time, and return
+// true if any round has been played. The rounds missed while the Schedule
+// was paused or the agent was down are not played again: at most one round
+// of each kind is played per call.
+func (r *Region) Tick(now time.Time) bool {
+	s := &r.Schedule
+	if s.Paused {
+		return false
+	}
+	played := false
+	if isDue(s.MovePeriod, s.LastMove, now) {
+		r.Move()
+		s.LastMove = now.Unix()
+		played = true
+	}
+	if isDue(s.ProducePeriod, s.LastProduce, now) {
+		r.Produce()
+		s.LastProduce = now.Unix()
+		played = true
+	}
+	return played
+}
+
+// Set the periods, in seconds, of the automatic rounds of the Region
+func (r *Region) SetSchedule(move, produce uint32) {
+	r.Schedule.MovePeriod = move
+	r.Schedule.ProducePeriod = produce
+}
+
+// Suspend or restart the automatic rounds of the Region
+func (r *Region) PauseSchedule(paused bool) {
+	r.Schedule.Paused = paused
+}
@@ -0,0 +1,150 @@
+// Copyright (C) 2018-2020 Hegemonie's AUTHORS
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package region
+
+import (
+	"errors"
+	"github.com/google/uuid"
+	"math/rand"
+)
+
+// Return the total Intelligence of the current City
+func (c *City) GetActualIntelligence(w *World) int64 {
+	return c.PermanentIntelligence
+}
+
+// Decide the outcome of an intelligence operation led by the current City
+// against the victim. The chances of success are proportional to the share
+// of the actor in the total intelligence of both cities.
+func (c *City) spySucceeds(w *World, victim *City) bool {
+	actor := c.GetActualIntelligence(w)
+	target := victim.GetActualIntelligence(w)
+	if actor <= 0 {
+		return false
+	}
+	if target <= 0 {
+		return true
+	}
+	return rand.Int63n(actor+target) < actor
+}
+
+func (c *City) spyCheck(victim *City) error {
+	if victim == nil {
+		return errCityNotFound
+	}
+	if victim == c {
+		return errors.New("Cannot spy itself")
+	}
+	return nil
+}
+
+// Charge the cost of an intelligence operation against the victim, then start
+// the cooldown before the next operation against the same victim.
+func (c *City) spyStart(w *World, victim *City) error {
+	if c.SpyCooldowns[victim.ID] > 0 {
+		return errSpyCooldown
+	}
+	if !c.Stock.GreaterOrEqualTo(w.Config.SpyCost) {
+		return ErrNotEnoughResources
+	}
+	c.Stock.Remove(w.Config.SpyCost)
+	if w.Config.SpyCooldown > 0 {
+		if c.SpyCooldowns == nil {
+			c.SpyCooldowns = make(map[uint64]uint32)
+		}
+		c.SpyCooldowns[victim.ID] = w.Config.SpyCooldown
+	}
+	return nil
+}
+
+// Make one production round elapse on the cooldowns of the intelligence operations
+func (c *City) spyCoolDown() {
+	for id, ticks := range c.SpyCooldowns {
+		if ticks <= 1 {
+			delete(c.SpyCooldowns, id)
+		} else {
+			c.SpyCooldowns[id] = ticks - 1
+		}
+	}
+}
+
+func (c *City) spyCaught(w *World) {
+	c.ChangePopularity(PopReasonSpyCaught, 0, w.Config.PopBonusSpyCaught)
+}
+
+// Try to reveal the complete state of the victim City.
+// Each attempt costs the configured resources and starts a cooldown against the victim.
+// The caller is responsible for the actual reveal, in case of a success.
+func (c *City) SpyReveal(w *Region, victim *City) (bool, error) {
+	if err := c.spyCheck(victim); err != nil {
+		return false, err
+	}
+	if err := c.spyStart(w.world, victim); err != nil {
+		return false, err
+	}
+
+	ok := c.spySucceeds(w.world, victim)
+	if !ok {
+		c.spyCaught(w.world)
+	}
+
+	n := w.world.notifier
+	n.Spy(c).Item(c, victim).Reveal(ok).Send()
+	n.Spy(victim).Item(c, victim).Reveal(ok).Send()
+	return ok, nil
+}
+
+// Try to steal a Knowledge of the given type from the victim City.
+// The Knowledge must be compatible with the Knowledge of the current City,
+// including its requirements. Like a reveal, each attempt has a cost and a
+// cooldown. The attempt fails if the victim hasn't completely learned the
+// Knowledge. In case of a success, the current City immediately owns the
+// Knowledge.
+func (c *City) SpySteal(w *Region, victim *City, typeID uint64) (bool, error) {
+	if err := c.spyCheck(victim); err != nil {
+		return false, err
+	}
+
+	kType := w.world.KnowledgeTypeGet(typeID)
+	if kType == nil {
+		return false, errNoKnowledgeType
+	}
+	for _, k := range c.Knowledges {
+		if k.Type == typeID {
+			return false, errors.New("Already started")
+		}
+	}
+	if !CheckKnowledgeDependencies(c.ownedKnowledgeTypes(w), kType.Requires, kType.Conflicts) {
+		return false, errors.New("Conflict")
+	}
+	if err := c.spyStart(w.world, victim); err != nil {
+		return false, err
+	}
+
+	// Stealing a Knowledge the victim doesn't own is a mere failure, so that
+	// the operation reveals nothing more than a failed attempt.
+	found := false
+	for _, k := range victim.Knowledges {
+		if k.Type == typeID && k.Ticks == 0 {
+			found = true
+			break
+		}
+	}
+
+	ok := found && c.spySucceeds(w.world, victim)
+	if ok {
+		c.Knowledges.Add(&Knowledge{ID: uuid.New().String(), Type: typeID})
+		c.ChangePopularity(PopReasonStealActor, kType.ID, kType.PopBonusStealActor)
+		victim.ChangePopularity(PopReasonStealVictim, kType.ID, kType.PopBonusStealVictim)
+	} else {
+		c.spyCaught(w.world)
+	}
+
+	n := w.world.notifier
+	n.Spy(c).Item(c, victim).Steal(kType, ok).Send()
+	n.Spy(victim).Item(c, victim).Steal(kType, ok).Send()
+	return ok, nil
+}
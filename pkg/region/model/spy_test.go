@@ -0,0 +1,83 @@
+// Copyright (C) 2018-2020 Hegemonie's AUTHORS
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package region
+
+import "testing"
+
+func TestCity_Spy(t *testing.T) {
+	w := World{}
+	w.Init()
+	w.Config.PopBonusSpyCaught = -3
+	w.Config.SpyCost = ResourcesUniform(1)
+	w.Config.SpyCooldown = 2
+	w.Definitions.Knowledges.Add(&KnowledgeType{
+		ID: 1, Name: "k", PopBonusStealActor: 2, PopBonusStealVictim: -1,
+	})
+	w.Definitions.Knowledges.Add(&KnowledgeType{ID: 3, Name: "k3", Requires: []uint64{1}})
+	w.Definitions.Knowledges.Add(&KnowledgeType{ID: 4, Name: "k4"})
+
+	r, _ := w.CreateRegion("test", "test")
+	c0, _ := r.CityCreate(1)
+	c1, _ := r.CityCreate(2)
+	c1.Knowledges.Add(&Knowledge{ID: "k0", Type: 1})
+	c1.Knowledges.Add(&Knowledge{ID: "k3", Type: 3})
+	c0.Stock.Set(ResourcesUniform(2))
+
+	if _, err := c0.SpyReveal(r, c0); err == nil {
+		t.Fatal()
+	}
+
+	// Without any intelligence, the operation always fails
+	if ok, err := c0.SpyReveal(r, c1); err != nil || ok {
+		t.Fatal()
+	}
+	if c0.PermanentPopularity != -3 || !c0.Stock.Equals(ResourcesUniform(1)) {
+		t.Fatal()
+	}
+
+	// The victim cannot be targeted again before the end of the cooldown
+	if _, err := c0.SpyReveal(r, c1); err != errSpyCooldown {
+		t.Fatal(err)
+	}
+	c0.spyCoolDown()
+	c0.spyCoolDown()
+
+	// Against a victim without intelligence, the operation always succeeds
+	c0.PermanentIntelligence = 10
+	c0.PermanentPopularity = 0
+	if _, err := c0.SpySteal(r, c1, 2); err == nil {
+		t.Fatal()
+	}
+	// The requirements of the Knowledge apply
+	if _, err := c0.SpySteal(r, c1, 3); err == nil {
+		t.Fatal()
+	}
+	if ok, err := c0.SpySteal(r, c1, 1); err != nil || !ok {
+		t.Fatal()
+	}
+	if len(c0.Knowledges) != 1 || c0.PermanentPopularity != 2 || c1.PermanentPopularity != -1 {
+		t.Fatal()
+	}
+	if _, err := c0.SpySteal(r, c1, 1); err == nil {
+		t.Fatal()
+	}
+
+	// Probing a Knowledge the victim lacks is charged like any failure
+	c0.SpyCooldowns = nil
+	c0.Stock.Set(ResourcesUniform(1))
+	if ok, err := c0.SpySteal(r, c1, 4); err != nil || ok {
+		t.Fatal(err)
+	}
+	if c0.SpyCooldowns[c1.ID] != 2 || !c0.Stock.IsZero() || len(c0.Knowledges) != 1 {
+		t.Fatal()
+	}
+
+	// Without resources, no operation is possible
+	c0.SpyCooldowns = nil
+	if _, err := c0.SpyReveal(r, c1); err != ErrNotEnoughResources {
+		t.Fatal(err)
+	}
+}
@@ -43,6 +43,24 @@ const (
 	CmdCityDefend = "defend"
 )
 
+const (
+	// The armies of both parties never fight each other, and without any
+	// explicit posture they join the defense of the cities of the other party.
+	TreatyAlliance = "alliance"
+
+	// The armies of both parties never assault each other
+	TreatyNonAggression = "non-aggression"
+
+	// Both parties agree to trade: the offers they accept from each other on
+	// the market are delivered instantly, without any transport.
+	TreatyTrade = "trade"
+
+	// Unilaterally declared: the armies of the declarer, but the transports,
+	// assault the cities and the armies of the target, unless a posture says
+	// otherwise. Only the declarer may withdraw it.
+	TreatyWar = "war"
+)
+
 type World struct {
 	// Core configuration common to all the Regions of the current World.
 	Config Configuration
@@ -81,6 +99,75 @@ type Configuration struct {
 	// Transient bonus to the Popularity of a City for each of its live Army
 	PopBonusArmyAlive int64
 
+	// Permanent bonus to the Popularity when an Army of the City changes its
+	// side in a Fight. Most likely negative, betrayals are rarely appreciated.
+	PopBonusArmyFlip int64
+
+	// Permanent bonus to the Popularity of a City when one of its intelligence
+	// operations fails. Most likely negative, the victim knows who did it.
+	PopBonusSpyCaught int64
+
+	// Resources spent by a City at each of its intelligence operations
+	SpyCost Resources
+
+	// How many production rounds a City must wait between two intelligence
+	// operations against the same victim.
+	SpyCooldown uint32
+
+	// Ratio of its maximal Health each Unit of a City loses at each production
+	// round when the City cannot pay the upkeep of its troops.
+	// Must be between 0 and 1.
+	StarvationImpact float64
+
+	// Ratio of its maximal Health each wounded Unit staying in a City recovers
+	// at each production round, before the modifiers of the City apply.
+	// Must be between 0 and 1.
+	HealRate float64
+
+	// Ratio of the resources already spent on an item in progress (Building,
+	// Knowledge or Unit) that is given back when the item is cancelled.
+	// Must be between 0 and 1.
+	CancelRefund float64
+
+	// How a City is picked for a Character joining a Region, when the
+	// Character doesn't tell: "random", "farthest" or "cell".
+	// An empty value stands for "random".
+	OnboardingStrategy string
+
+	// Default periods, in seconds, of the movement and production rounds of
+	// the Regions created. A null value disables the automatic rounds.
+	MovePeriod    uint32
+	ProducePeriod uint32
+
+	// Ratio of its Health each Unit loses when its Army flees a Fight.
+	// Must be between 0 and 1.
+	FleaPenalty float64
+
+	// Probability for a healthy City to spontaneously start an epidemic,
+	// at each production round, before the City Health is considered.
+	EpidemicOutbreakRate float64
+
+	// Probability for an epidemic to spread to a neighbor City or to an Army
+	// in contact, at each round, before the City Health is considered.
+	EpidemicSpreadRate float64
+
+	// How many production rounds an epidemic lasts in a City or an Army.
+	EpidemicDuration uint32
+
+	// Multiplier applied to the production of a sick City, before the City
+	// Health is considered. Must be between 0 and 1.
+	EpidemicProdImpact float64
+
+	// Ratio of its maximal Health each Unit of a sick City or Army loses at
+	// each production round, before the City Health is considered.
+	// Must be between 0 and 1.
+	EpidemicUnitImpact float64
+
+	// Should the Cities in automatic mode start the study of a Knowledge or
+	// the construction of a Building when their stock is full and nothing is
+	// pending. Otherwise, the Cities in automatic mode only defend themselves.
+	AutoEvolve bool
+
 	// Default Overlord rate: percentage of the production of a City that is
 	// taxed by its Overlord
 	RateOverlord float64
@@ -101,6 +188,9 @@ type DefinitionsBase struct {
 	// All the possible Knowledge that can be learned in Cities of the current World
 	// IMMUTABLE: Only read accesses allowed.
 	Knowledges SetOfKnowledgeTypes
+	// All the possible Artifacts that can be found in the current World
+	// IMMUTABLE: Only read accesses allowed.
+	Artifacts SetOfArtifactTypes
 }
 
 type Region struct {
@@ -113,17 +203,37 @@ type Region struct {
 	// All the cities present on the Region
 	Cities SetOfCities
 
-	// Fights currently happening. The armies involved in the Fight are owned
-	// By the Fight and do not appear in the "Armies" field.
+	// Fights currently happening. The armies involved in a Fight stay in the
+	// "Armies" field of their City, and the Fight refers to the same objects
+	// once they are relinked by PostLoad.
 	Fights SetOfFights
 
+	// Treaties proposed or in force between the characters of the Region
+	Treaties SetOfTreaties
+
+	// The order book of the market of the Region
+	Market SetOfOffers
+
+	// When the rounds of the Region are automatically played
+	Schedule Schedule
+
 	// Back-pointer to the World the current Region belongs to.
 	world *World
 }
 
+// The location of a City on the map of a Region, with its initial name
+type CitySite struct {
+	ID   uint64
+	Name string
+}
+
 // Map actions that are exposed to a World
 type MapView interface {
-	Step(src, dst uint64) (uint64, error)
+	// Return the next step on the path from src to dst, on the given map
+	Step(mapName string, src, dst uint64) (uint64, error)
+
+	// Return the locations directly reachable from id by a road, on the given map
+	Neighbors(mapName string, id uint64) ([]uint64, error)
 }
 
 type Resources [ResourceMax]uint64
@@ -132,12 +242,32 @@ type ResourcesIncrement [ResourceMax]int64
 
 type ResourcesMultiplier [ResourceMax]float64
 
+type ArtifactType struct {
+	// Unique ID of the ArtifactType
+	ID uint64 `json:"Id"`
+
+	// Display name of the ArtifactType
+	Name string
+
+	// Are the Artifacts of that type visible by the other players, by default
+	Visible bool `json:",omitempty"`
+
+	// Transient bonus of Popularity, when the Artifact is placed in a City
+	PopBonus int64
+
+	// Impact of the Artifact on the total storage capacity of the City it is placed in.
+	Stock ResourceModifiers
+
+	// Impact of the Artifact on the production of the City it is placed in.
+	Prod ResourceModifiers
+}
+
 type Artifact struct {
 	// UUID
 	ID string `json:"id"`
-	// UUID
-	Type string `json:"type"`
-	// UUID
+	// The unique ID of the ArtifactType associated to the current Artifact
+	Type uint64 `json:"type"`
+	// Display name of the Artifact
 	Name    string `json:"name"`
 	Visible bool   `json:"visible,omitempty"`
 }
@@ -151,13 +281,19 @@ type CityProduction struct {
 	Base      Resources
 	Knowledge ResourceModifiers
 	Buildings ResourceModifiers
+	Artifacts ResourceModifiers
+	Troops    ResourceModifiers
 	Actual    Resources
+
+	// Part of the upkeep of the Troops that the production cannot cover
+	Shortage Resources
 }
 
 type CityStock struct {
 	Base      Resources
 	Knowledge ResourceModifiers
 	Buildings ResourceModifiers
+	Artifacts ResourceModifiers
 	Actual    Resources
 
 	Usage Resources
@@ -180,6 +316,12 @@ type KnowledgeType struct {
 	// Permanent bonus of Popularity (to the robber) when the Knowledge is stolen
 	PopBonusStealActor int64
 
+	// Transient bonus of Health, when the Knowledge is present
+	HealthBonus int64 `json:",omitempty"`
+
+	// Bonus to the healing rate of the wounded Units, when the Knowledge is present
+	HealBonus float64 `json:",omitempty"`
+
 	// Impat of the current Building on the total storage capacity of the City.
 	Stock ResourceModifiers
 
@@ -204,6 +346,9 @@ type Knowledge struct {
 	ID    string `json:"Id"`
 	Type  uint64
 	Ticks uint32 `json:",omitempty"`
+
+	// Resources actually spent on the Knowledge, the base of any refund
+	Paid Resources `json:",omitempty"`
 }
 
 type BuildingType struct {
@@ -243,6 +388,17 @@ type BuildingType struct {
 	// Permanent bonus of Popularity given to the owner of the Building when it is dismantled.
 	PopBonusDismantle int64
 
+	// Ratio of the resources actually paid for the Building (Cost0 plus Cost
+	// for each tick) given back to the City when the Building is dismantled.
+	// Must be between 0 and 1.
+	DismantleRefund float64 `json:",omitempty"`
+
+	// Transient bonus of Health, when the Building is alive
+	HealthBonus int64 `json:",omitempty"`
+
+	// Bonus to the healing rate of the wounded Units, when the Building is alive
+	HealBonus float64 `json:",omitempty"`
+
 	// Impat of the current Building on the total storage capacity of the City.
 	Stock ResourceModifiers
 
@@ -267,6 +423,9 @@ type Building struct {
 
 	// How many construction rounds remain before the building's achievement
 	Ticks uint32 `json:",omitempty"`
+
+	// Resources actually spent on the Building, the base of any refund
+	Paid Resources `json:",omitempty"`
 }
 
 type City struct {
@@ -297,6 +456,9 @@ type City struct {
 	// The total value is the permanent value plus several "transient" bonus
 	PermanentPopularity int64
 
+	// The last permanent changes of the Popularity, the oldest first
+	PopularityHistory []PopularityChange `json:",omitempty"`
+
 	// Permanent Health of the current City. In other words, how it resists
 	// to diseases, propagates pandemies, etc.
 	// Higher is better.
@@ -309,6 +471,10 @@ type City struct {
 	// The total value is the permanent value plus several "transient" bonus
 	PermanentIntelligence int64
 
+	// Production rounds to wait before the next intelligence operation
+	// against each City, by ID.
+	SpyCooldowns map[uint64]uint32 `json:",omitempty"`
+
 	// From Lawful (<0) to Chaotic (>0) (0 for neutral)
 	Chaotic int32
 
@@ -339,12 +505,19 @@ type City struct {
 	// It takes one production turn to recover one Massacre.
 	TicksMassacres uint32 `json:",omitempty"`
 
+	// Number of production rounds the ongoing epidemic will still last in the
+	// current City (0 means healthy).
+	TicksEpidemic uint32 `json:",omitempty"`
+
 	// Tells if the City is in automatic mode.
 	// The "auto" mode is intented for inactive or absent players.
-	// The armies come home to defend the City, no new building or unit is spawned.
-	// In the plans: a conservative behavior should be automated
+	// The armies come home to defend the City, no new unit is spawned and
+	// a conservative evolution may be followed (see Configuration.AutoEvolve).
 	Auto bool `json:",omitempty"`
 
+	// How the leftover Stock is spent on the items in progress
+	Queue ProductionQueue
+
 	Knowledges SetOfKnowledges
 
 	Buildings SetOfBuildings
@@ -389,6 +562,31 @@ type UnitType struct {
 	// How many ticks
 	Ticks uint32
 
+	// Amount of damage points inflicted at each round of a Fight by a Unit
+	// of that type, at its full capacity.
+	Attack uint32 `json:",omitempty"`
+
+	// Amount of damage points absorbed at each round of a Fight, before the
+	// Armor class applies.
+	Defense uint32 `json:",omitempty"`
+
+	// Percentage of the damage (after the Defense applied) that is ignored.
+	// Must be between 0 and 100.
+	Armor uint32 `json:",omitempty"`
+
+	// Number of cells of the Map the Unit would cross at each movement round.
+	// Only displayed for now: the Armies move one cell per round, whatever
+	// their Units.
+	Speed uint32 `json:",omitempty"`
+
+	// Amount of resources a Unit of that type would carry when it is part of
+	// an Army. Only displayed for now: neither the transports nor the market
+	// enforce it.
+	Carry uint64 `json:",omitempty"`
+
+	// Multipliers applied to the Attack when hitting Units of specific types.
+	Bonus []UnitTypeBonus `json:",omitempty"`
+
 	// Transient bonus of Popularity, when the Unit is alive
 	PopBonus int64
 
@@ -420,6 +618,15 @@ type UnitType struct {
 	RequiredBuilding uint64
 }
 
+// A multiplier applied to the Attack of a UnitType against another UnitType
+type UnitTypeBonus struct {
+	// The unique ID of the UnitType targeted
+	Type uint64
+
+	// Multiplier of the Attack. Must be positive.
+	Mult float64
+}
+
 // Both Cell and City must not be 0, and have a non-0 value
 type Unit struct {
 	// Unique ID of the Unit
@@ -433,6 +640,9 @@ type Unit struct {
 
 	// The number of health points of the unit, Health should be less or equal to HealthMax
 	Health uint32 `json:"H,omitempty"`
+
+	// Resources actually spent on the training of the Unit, the base of any refund
+	Paid Resources `json:",omitempty"`
 }
 
 // A queued order for a specific Army.
@@ -452,11 +662,11 @@ type ActionArgMove struct {
 	// Resources to be transferred
 	Amount Resources `json:"amount,omitempty"`
 
-	// Artifact to be transferred
-	Artifact uint64 `json:"artifact,omitempty"`
+	// Artifacts to be transferred
+	Artifacts []string `json:"artifacts,omitempty"`
 
 	// Troops to be transferred
-	Units []uint64 `json:"units,omitempty"`
+	Units []string `json:"units,omitempty"`
 }
 
 // What to do if the army is victorious
@@ -502,13 +712,69 @@ type Army struct {
 	// The set may be empty
 	Artifacts SetOfArtifacts `json:",omitempty"`
 
+	// Number of production rounds the epidemic will still last in the current
+	// Army (0 means healthy).
+	TicksEpidemic uint32 `json:",omitempty"`
+
 	// The IS of a Cell of the Map that is a goal of the current movement of the Army
 	Targets []Command `json:",omitempty"`
 
 	// An array of Postures against armies of other cities.
+	// The absolute value is the ID of the City.
 	// A positive value means "defend"
 	// A negative value means "assault"
 	Postures []int64 `json:",omitempty"`
+
+	// An array of Postures against all the cities of other characters.
+	// A City-specific Posture has the precedence on the Character Posture.
+	PosturesCharacter []CharacterPosture `json:",omitempty"`
+}
+
+// An agreement between two characters of the Region, or a declaration of war
+type Treaty struct {
+	// The unique ID of the Treaty
+	ID string `json:"Id"`
+
+	// One of TreatyAlliance, TreatyNonAggression, TreatyTrade or TreatyWar
+	Kind string
+
+	// The unique ID of the Character who proposed the Treaty
+	Proposer string
+
+	// The unique ID of the Character the Treaty has been proposed to
+	Target string
+
+	// Is the Treaty in force. A declaration of war is in force immediately,
+	// the other kinds require the acceptation of the target.
+	Active bool `json:",omitempty"`
+}
+
+// An Offer posted by a City on the market of its Region: the City gives the
+// Goods in exchange of the Price. A sell offer asks some gold in exchange of
+// other resources while a buy offer gives gold, but any swap is accepted.
+type Offer struct {
+	// The unique ID of the Offer
+	ID string `json:"Id"`
+
+	// The unique ID of the City that posted the Offer
+	City uint64
+
+	// Resources given by the City. They are preempted from the Stock of the
+	// City when the Offer is posted and until it is accepted or cancelled.
+	Goods Resources
+
+	// Resources expected in exchange of the Goods
+	Price Resources
+}
+
+// The attitude of an Army toward all the cities of a Character
+type CharacterPosture struct {
+	// The unique ID of the Character
+	Character string
+
+	// A positive value means "defend"
+	// A negative value means "assault"
+	Value int64
 }
 
 type Fight struct {
@@ -530,6 +796,7 @@ type Fight struct {
 type SetOfFights []*Fight
 
 //go:generate go run github.com/jfsmig/hegemonie/cmd/gen-set ./world_auto.go region:SetOfArtifacts:*Artifact ID:string
+//go:generate go run github.com/jfsmig/hegemonie/cmd/gen-set ./world_auto.go region:SetOfArtifactTypes:*ArtifactType
 //go:generate go run github.com/jfsmig/hegemonie/cmd/gen-set ./world_auto.go region:SetOfArmies:*Army ID:string
 //go:generate go run github.com/jfsmig/hegemonie/cmd/gen-set ./world_auto.go region:SetOfBuildings:*Building ID:string
 //go:generate go run github.com/jfsmig/hegemonie/cmd/gen-set ./world_auto.go region:SetOfBuildingTypes:*BuildingType
@@ -540,3 +807,5 @@ type SetOfFights []*Fight
 //go:generate go run github.com/jfsmig/hegemonie/cmd/gen-set ./world_auto.go region:SetOfUnits:*Unit ID:string
 //go:generate go run github.com/jfsmig/hegemonie/cmd/gen-set ./world_auto.go region:SetOfUnitTypes:*UnitType
 //go:generate go run github.com/jfsmig/hegemonie/cmd/gen-set ./world_auto.go region:SetOfRegions:*Region Name:string
+//go:generate go run github.com/jfsmig/hegemonie/cmd/gen-set ./world_auto.go region:SetOfTreaties:*Treaty ID:string
+//go:generate go run github.com/jfsmig/hegemonie/cmd/gen-set ./world_auto.go region:SetOfOffers:*Offer ID:string
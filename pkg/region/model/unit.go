@@ -33,3 +33,45 @@ func (u *Unit) Finish() *Unit {
 	u.Ticks = 0
 	return u
 }
+
+// Return the ratio of its nominal capacity the Unit is still able to deliver,
+// given its current Health and the HealthFactor of its UnitType.
+func (u *Unit) Capacity(ut *UnitType) float64 {
+	if ut == nil || ut.Health == 0 || u.Health == 0 {
+		return 0
+	}
+	ratio := float64(u.Health) / float64(ut.Health)
+	if ratio > 1 {
+		ratio = 1
+	}
+	return 1.0 - ut.HealthFactor*(1.0-ratio)
+}
+
+// Disband idle Units of the City: the Units must stay in the City and their
+// training must be over. The City gets the popularity bonus of each UnitType.
+func (c *City) DisbandUnits(w *Region, ids ...string) error {
+	units := make([]*Unit, 0, len(ids))
+	seen := make(map[string]bool)
+	for _, id := range ids {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		u := c.Units.Get(id)
+		if u == nil {
+			return ErrNoSuchUnit
+		}
+		if u.Ticks > 0 {
+			return errUnderTraining
+		}
+		units = append(units, u)
+	}
+
+	for _, u := range units {
+		c.Units.Remove(u)
+		if ut := w.world.UnitTypeGet(u.Type); ut != nil {
+			c.ChangePopularity(PopReasonDisband, ut.ID, ut.PopBonusDisband)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,67 @@
+// Copyright (C) 2018-2020 Hegemonie's AUTHORS
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package region
+
+// Return all the Units of the City, in the City itself and in its Armies.
+func (c *City) AllUnits() []*Unit {
+	out := make([]*Unit, 0, len(c.Units))
+	out = append(out, c.Units...)
+	for _, a := range c.Armies {
+		out = append(out, a.Units...)
+	}
+	return out
+}
+
+// Take the shortage of upkeep from the Stock of the City. If the Stock
+// doesn't hold enough resources, it is emptied and the troops starve.
+func (c *City) PayUpkeep(w *Region, shortage Resources) {
+	if c.Stock.GreaterOrEqualTo(shortage) {
+		c.Stock.Remove(shortage)
+		return
+	}
+
+	for i := 0; i < ResourceMax; i++ {
+		if shortage[i] > c.Stock[i] {
+			c.Stock[i] = 0
+		} else {
+			c.Stock[i] -= shortage[i]
+		}
+	}
+	c.Starve(w)
+}
+
+// Make the Units of the City and of its Armies starve: each Unit loses a
+// part of its Health and the dead Units are removed.
+func (c *City) Starve(w *Region) {
+	ratio := w.world.Config.StarvationImpact
+	if ratio <= 0 {
+		return
+	}
+
+	starve := func(units *SetOfUnits) {
+		for _, u := range *units {
+			ut := w.world.UnitTypeGet(u.Type)
+			if ut == nil {
+				continue
+			}
+			loss := uint32(float64(ut.Health)*ratio + 0.5)
+			if loss == 0 {
+				loss = 1
+			}
+			if loss < u.Health {
+				u.Health -= loss
+			} else {
+				u.Health = 0
+			}
+		}
+		c.buryDead(w.world, units)
+	}
+
+	starve(&c.Units)
+	for _, a := range c.Armies {
+		starve(&a.Units)
+	}
+}
@@ -0,0 +1,304 @@
+// Copyright (C) 2018-2020 Hegemonie's AUTHORS
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package region
+
+import (
+	"fmt"
+	"strings"
+)
+
+// DefinitionErrors gathers all the problems found in the definitions of a World
+type DefinitionErrors []error
+
+func (e DefinitionErrors) Error() string {
+	msg := make([]string, 0, len(e))
+	for _, err := range e {
+		msg = append(msg, err.Error())
+	}
+	return strings.Join(msg, "; ")
+}
+
+type defsValidator struct {
+	d    *DefinitionsBase
+	errs DefinitionErrors
+
+	knowledges map[uint64]*KnowledgeType
+	buildings  map[uint64]*BuildingType
+	units      map[uint64]bool
+
+	// Transitive closure of the requirements of each KnowledgeType.
+	// It is partial for the types involved in a cycle.
+	closure map[uint64]map[uint64]bool
+	// Tells if a KnowledgeType can ever be learned
+	reachable map[uint64]bool
+}
+
+func (v *defsValidator) fail(format string, args ...interface{}) {
+	v.errs = append(v.errs, fmt.Errorf(format, args...))
+}
+
+// Validate checks the referential integrity of the definitions: unique IDs,
+// references to known types, no cycle among the requirements of the
+// Knowledges, no contradiction between the requirements and the conflicts,
+// no type that can never be reached, and the values of the types within
+// their bounds. All the problems are reported.
+func (d *DefinitionsBase) Validate() DefinitionErrors {
+	v := defsValidator{
+		d:          d,
+		knowledges: make(map[uint64]*KnowledgeType),
+		buildings:  make(map[uint64]*BuildingType),
+		units:      make(map[uint64]bool),
+		closure:    make(map[uint64]map[uint64]bool),
+		reachable:  make(map[uint64]bool),
+	}
+	v.checkIDs()
+	v.checkKnowledges()
+	v.checkBuildings()
+	v.checkUnits()
+	v.checkValues()
+	return v.errs
+}
+
+func (v *defsValidator) checkIDs() {
+	for _, kt := range v.d.Knowledges {
+		if _, ok := v.knowledges[kt.ID]; ok {
+			v.fail("knowledge type %d: duplicate ID", kt.ID)
+		}
+		v.knowledges[kt.ID] = kt
+	}
+	for _, bt := range v.d.Buildings {
+		if bt.ID == 0 {
+			v.fail("building type 0: reserved ID")
+		}
+		if _, ok := v.buildings[bt.ID]; ok {
+			v.fail("building type %d: duplicate ID", bt.ID)
+		}
+		v.buildings[bt.ID] = bt
+	}
+	for _, ut := range v.d.Units {
+		if v.units[ut.ID] {
+			v.fail("unit type %d: duplicate ID", ut.ID)
+		}
+		v.units[ut.ID] = true
+	}
+	artifacts := make(map[uint64]bool)
+	for _, at := range v.d.Artifacts {
+		if artifacts[at.ID] {
+			v.fail("artifact type %d: duplicate ID", at.ID)
+		}
+		artifacts[at.ID] = true
+	}
+}
+
+// Check the references of a set of requirements and conflicts, and return
+// true if they are all valid.
+func (v *defsValidator) checkRefs(what string, id uint64, requires, conflicts []uint64) bool {
+	ok := true
+	for _, r := range requires {
+		if v.knowledges[r] == nil {
+			v.fail("%s type %d: requires unknown knowledge type %d", what, id, r)
+			ok = false
+		}
+	}
+	for _, c := range conflicts {
+		if v.knowledges[c] == nil {
+			v.fail("%s type %d: conflicts with unknown knowledge type %d", what, id, c)
+			ok = false
+		}
+		for _, r := range requires {
+			if r == c {
+				v.fail("%s type %d: knowledge type %d both required and conflicting", what, id, r)
+				ok = false
+			}
+		}
+	}
+	return ok
+}
+
+// Check that none of the Knowledges required, directly or not, conflicts
+// with another one or with the item itself. The conflict of a Knowledge
+// with itself is tolerated.
+func (v *defsValidator) checkContradictions(what string, id uint64, requires, conflicts []uint64) bool {
+	all := make(map[uint64]bool)
+	for _, r := range requires {
+		all[r] = true
+		for x := range v.closure[r] {
+			all[x] = true
+		}
+	}
+
+	ok := true
+	for _, c := range conflicts {
+		if all[c] {
+			v.fail("%s type %d: indirectly requires the conflicting knowledge type %d", what, id, c)
+			ok = false
+		}
+	}
+	for _, x := range v.d.Knowledges {
+		if !all[x.ID] {
+			continue
+		}
+		for _, c := range x.Conflicts {
+			if c != x.ID && all[c] {
+				v.fail("%s type %d: requires knowledge types %d and %d that conflict", what, id, x.ID, c)
+				ok = false
+			}
+		}
+	}
+	return ok
+}
+
+func (v *defsValidator) checkKnowledges() {
+	valid := make(map[uint64]bool)
+	for _, kt := range v.d.Knowledges {
+		valid[kt.ID] = v.checkRefs("knowledge", kt.ID, kt.Requires, kt.Conflicts)
+	}
+
+	// Detect the cycles among the requirements with a depth-first walk,
+	// and compute the closure of the requirements on the way back.
+	const (
+		unseen = iota
+		walking
+		done
+	)
+	state := make(map[uint64]int)
+	inCycle := make(map[uint64]bool)
+	var walk func(kt *KnowledgeType, path []uint64)
+	walk = func(kt *KnowledgeType, path []uint64) {
+		state[kt.ID] = walking
+		path = append(path, kt.ID)
+		closure := make(map[uint64]bool)
+		for _, r := range kt.Requires {
+			next := v.knowledges[r]
+			if next == nil {
+				continue
+			}
+			switch state[r] {
+			case walking:
+				for i, x := range path {
+					if x == r {
+						cycle := append(append([]uint64{}, path[i:]...), r)
+						v.fail("knowledge type %d: cycle in the requirements %v", r, cycle)
+						for _, y := range path[i:] {
+							inCycle[y] = true
+						}
+					}
+				}
+			case unseen:
+				walk(next, path)
+			}
+			closure[r] = true
+			for x := range v.closure[r] {
+				closure[x] = true
+			}
+		}
+		state[kt.ID] = done
+		v.closure[kt.ID] = closure
+	}
+	for _, kt := range v.d.Knowledges {
+		if state[kt.ID] == unseen {
+			walk(kt, nil)
+		}
+	}
+
+	for _, kt := range v.d.Knowledges {
+		if inCycle[kt.ID] {
+			valid[kt.ID] = false
+		} else if !v.checkContradictions("knowledge", kt.ID, kt.Requires, kt.Conflicts) {
+			valid[kt.ID] = false
+		}
+	}
+
+	// A Knowledge is reachable when it is valid and all the Knowledges it
+	// requires are reachable. The closures make the order irrelevant.
+	for _, kt := range v.d.Knowledges {
+		if !valid[kt.ID] {
+			continue
+		}
+		ok := true
+		for _, x := range v.d.Knowledges {
+			if v.closure[kt.ID][x.ID] && !valid[x.ID] {
+				v.fail("knowledge type %d: unreachable, depends on the invalid knowledge type %d", kt.ID, x.ID)
+				ok = false
+			}
+		}
+		v.reachable[kt.ID] = ok
+	}
+}
+
+func (v *defsValidator) checkBuildings() {
+	for _, bt := range v.d.Buildings {
+		if !v.checkRefs("building", bt.ID, bt.Requires, bt.Conflicts) {
+			continue
+		}
+		v.checkContradictions("building", bt.ID, bt.Requires, bt.Conflicts)
+		for _, r := range bt.Requires {
+			if !v.reachable[r] {
+				v.fail("building type %d: unreachable, requires the unreachable knowledge type %d", bt.ID, r)
+			}
+		}
+	}
+}
+
+func (v *defsValidator) checkUnits() {
+	buildable := func(bt *BuildingType) bool {
+		for _, r := range bt.Requires {
+			if !v.reachable[r] {
+				return false
+			}
+		}
+		return true
+	}
+	for _, ut := range v.d.Units {
+		if ut.RequiredBuilding == 0 {
+			continue
+		}
+		bt := v.buildings[ut.RequiredBuilding]
+		if bt == nil {
+			v.fail("unit type %d: requires unknown building type %d", ut.ID, ut.RequiredBuilding)
+		} else if !buildable(bt) {
+			v.fail("unit type %d: unreachable, requires the unreachable building type %d", ut.ID, bt.ID)
+		}
+	}
+}
+
+func (v *defsValidator) checkValues() {
+	for _, bt := range v.d.Buildings {
+		if bt.DismantleRefund < 0 || bt.DismantleRefund > 1 {
+			v.fail("building type %d: dismantle refund out of [0,1]", bt.ID)
+		}
+	}
+	for _, ut := range v.d.Units {
+		if ut.HealthFactor < 0 || ut.HealthFactor > 1 {
+			v.fail("unit type %d: health factor out of [0,1]", ut.ID)
+		}
+		for _, m := range ut.Prod.Mult {
+			if m < 0 {
+				v.fail("unit type %d: negative production multiplier", ut.ID)
+				break
+			}
+		}
+		if ut.Armor > 100 {
+			v.fail("unit type %d: armor out of [0,100]", ut.ID)
+		}
+		for _, b := range ut.Bonus {
+			if !v.units[b.Type] {
+				v.fail("unit type %d: bonus against unknown unit type %d", ut.ID, b.Type)
+			}
+			if b.Mult < 0 {
+				v.fail("unit type %d: negative bonus against unit type %d", ut.ID, b.Type)
+			}
+		}
+	}
+	for _, at := range v.d.Artifacts {
+		for i := range at.Stock.Mult {
+			if at.Stock.Mult[i] < 0 || at.Prod.Mult[i] < 0 {
+				v.fail("artifact type %d: negative multiplier", at.ID)
+				break
+			}
+		}
+	}
+}
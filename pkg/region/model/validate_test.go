@@ -0,0 +1,139 @@
+// Copyright (C) 2018-2020 Hegemonie's AUTHORS
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package region
+
+import (
+	"io/ioutil"
+	"testing"
+)
+
+func TestDefinitions_Validate(t *testing.T) {
+	d := DefinitionsBase{}
+	d.Knowledges.Add(&KnowledgeType{ID: 1})
+	d.Knowledges.Add(&KnowledgeType{ID: 2, Requires: []uint64{1}, Conflicts: []uint64{2, 3}})
+	d.Knowledges.Add(&KnowledgeType{ID: 3, Requires: []uint64{1}, Conflicts: []uint64{2, 3}})
+	d.Buildings.Add(&BuildingType{ID: 1, Requires: []uint64{2}})
+	d.Units.Add(&UnitType{ID: 1, RequiredBuilding: 1})
+	d.Units.Add(&UnitType{ID: 2})
+	if errs := d.Validate(); len(errs) != 0 {
+		t.Fatal(errs)
+	}
+
+	// Unknown references
+	d.Knowledges.Add(&KnowledgeType{ID: 4, Requires: []uint64{9}})
+	d.Units.Add(&UnitType{ID: 3, RequiredBuilding: 9})
+	if errs := d.Validate(); len(errs) != 2 {
+		t.Fatal(errs)
+	}
+	d.Knowledges.Remove(d.Knowledges.Get(4))
+	d.Units.Remove(d.Units.Get(3))
+
+	// A cycle makes the dependent Knowledges, Buildings and Units unreachable
+	d.Knowledges.Add(&KnowledgeType{ID: 5, Requires: []uint64{6}})
+	d.Knowledges.Add(&KnowledgeType{ID: 6, Requires: []uint64{5}})
+	d.Knowledges.Add(&KnowledgeType{ID: 7, Requires: []uint64{6}})
+	d.Buildings.Add(&BuildingType{ID: 2, Requires: []uint64{7}})
+	d.Units.Add(&UnitType{ID: 4, RequiredBuilding: 2})
+	errs := d.Validate()
+	if len(errs) != 5 {
+		t.Fatal(errs)
+	}
+	d.Knowledges.Remove(d.Knowledges.Get(5))
+	d.Knowledges.Remove(d.Knowledges.Get(6))
+	d.Knowledges.Remove(d.Knowledges.Get(7))
+	d.Buildings.Remove(d.Buildings.Get(2))
+	d.Units.Remove(d.Units.Get(4))
+
+	// Contradictions
+	d.Knowledges.Add(&KnowledgeType{ID: 8, Requires: []uint64{2, 3}})
+	d.Buildings.Add(&BuildingType{ID: 3, Requires: []uint64{2}, Conflicts: []uint64{1}})
+	d.Buildings.Add(&BuildingType{ID: 4, Requires: []uint64{1}, Conflicts: []uint64{1}})
+	errs = d.Validate()
+	if len(errs) != 4 {
+		t.Fatal(errs)
+	}
+
+	// Duplicates
+	d = DefinitionsBase{}
+	d.Units = append(d.Units, &UnitType{ID: 1}, &UnitType{ID: 1})
+	if errs = d.Validate(); len(errs) != 1 {
+		t.Fatal(errs)
+	}
+}
+
+func TestConfiguration_Check(t *testing.T) {
+	c := Configuration{FleaPenalty: 0.5}
+	if err := c.Check(); err != nil {
+		t.Fatal(err)
+	}
+	c.FleaPenalty = 1.5
+	if err := c.Check(); err == nil {
+		t.Fatal()
+	}
+	c.FleaPenalty = 0
+	c.EpidemicSpreadRate = -0.1
+	if err := c.Check(); err == nil {
+		t.Fatal()
+	}
+	c.EpidemicSpreadRate = 0
+	c.StarvationImpact = 2
+	if err := c.Check(); err == nil {
+		t.Fatal()
+	}
+	c.StarvationImpact = 0
+	c.HealRate = -1
+	if err := c.Check(); err == nil {
+		t.Fatal()
+	}
+	c.HealRate = 0
+	c.CancelRefund = 1.1
+	if err := c.Check(); err == nil {
+		t.Fatal()
+	}
+}
+
+func TestDefinitions_CheckArtifacts(t *testing.T) {
+	d := DefinitionsBase{}
+	d.Artifacts.Add(&ArtifactType{ID: 1, Stock: ResourceModifierNoop(), Prod: ResourceModifierNoop()})
+	if err := d.Check(); err != nil {
+		t.Fatal(err)
+	}
+	d.Artifacts[0].Prod.Mult[0] = -1
+	if err := d.Check(); err == nil {
+		t.Fatal()
+	}
+}
+
+func TestDefinitions_CheckValues(t *testing.T) {
+	d := DefinitionsBase{}
+	d.Buildings.Add(&BuildingType{ID: 1, DismantleRefund: 1.5})
+	d.Units.Add(&UnitType{ID: 1, Armor: 101, Bonus: []UnitTypeBonus{{Type: 9, Mult: -1}}})
+	d.Artifacts.Add(&ArtifactType{ID: 1, Stock: ResourceModifierNoop(), Prod: ResourceModifierNoop()})
+	d.Artifacts[0].Stock.Mult[0] = -1
+
+	// All the problems are reported at once
+	err := d.Check()
+	errs, ok := err.(DefinitionErrors)
+	if !ok || len(errs) != 5 {
+		t.Fatal(err)
+	}
+}
+
+func TestDefinitions_LoadWithoutArtifacts(t *testing.T) {
+	dir := t.TempDir()
+	for _, name := range []string{"units", "buildings", "knowledge"} {
+		if err := ioutil.WriteFile(dir+"/"+name+".json", []byte("[]"), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	d := DefinitionsBase{}
+	if err := d.Sections(dir).Load(); err != nil {
+		t.Fatal(err)
+	}
+	if len(d.Artifacts) != 0 {
+		t.Fatal()
+	}
+}
@@ -16,17 +16,56 @@ func (w *World) RUnlock() { w.rw.RUnlock() }
 func (w *World) CreateRegion(name, mapName string) (*Region, error) {
 	w.WLock()
 	defer w.WUnlock()
+	return w.createRegion(name, mapName)
+}
+
+// Create a Region and populate it with one City per site of its map, each
+// City made after a pattern randomly picked in the configuration.
+func (w *World) CreateRegionFromSites(name, mapName string, sites []CitySite) (*Region, error) {
+	w.WLock()
+	defer w.WUnlock()
 
+	seen := make(map[uint64]bool)
+	for _, site := range sites {
+		if seen[site.ID] {
+			return nil, errCityExists
+		}
+		seen[site.ID] = true
+	}
+
+	r, err := w.createRegion(name, mapName)
+	if err != nil {
+		return nil, err
+	}
+	for _, site := range sites {
+		c, err := r.CityCreateRandom(site.ID)
+		if err != nil {
+			w.Regions.Remove(r)
+			return nil, err
+		}
+		c.Name = site.Name
+	}
+	return r, nil
+}
+
+func (w *World) createRegion(name, mapName string) (*Region, error) {
 	if w.Regions.Has(name) {
 		return nil, errRegionExists
 	}
 	r := &Region{
-		Name:    name,
-		MapName: mapName,
-		Cities:  make(SetOfCities, 0),
-		Fights:  make(SetOfFights, 0),
-		world:   w,
+		Name:     name,
+		MapName:  mapName,
+		Cities:   make(SetOfCities, 0),
+		Fights:   make(SetOfFights, 0),
+		Treaties: make(SetOfTreaties, 0),
+		Market:   make(SetOfOffers, 0),
+		Schedule: Schedule{
+			MovePeriod:    w.Config.MovePeriod,
+			ProducePeriod: w.Config.ProducePeriod,
+		},
+		world: w,
 	}
+	w.Regions.Add(r)
 	return r, nil
 }
 
@@ -54,3 +93,7 @@ func (w *World) KnowledgeTypeGet(id uint64) *KnowledgeType {
 func (w *World) KnowledgeGetFrontier(owned []*Knowledge) []*KnowledgeType {
 	return w.Definitions.Knowledges.Frontier(owned)
 }
+
+func (w *World) ArtifactTypeGet(id uint64) *ArtifactType {
+	return w.Definitions.Artifacts.Get(id)
+}
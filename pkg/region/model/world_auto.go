@@ -0,0 +1,1401 @@
+// Code generated : DO NOT EDIT.
+// Code generated : 2026-10-18 11:58:45.878028151 +0000 UTC m=+0.000105371
+
+// Copyright (C) 2018-2020 Hegemonie's AUTHORS
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package region
+
+import (
+	"sort"
+	"errors"
+)
+
+
+
+type SetOfArtifacts []*Artifact
+
+func (s SetOfArtifacts) CheckThenFail() {
+	if err := s.Check(); err != nil {
+		panic(err.Error())
+	}
+}
+
+func (s SetOfArtifacts) Len() int {
+	return len(s)
+}
+
+func (s SetOfArtifacts) Swap(i, j int) {
+	s[i], s[j] = s[j], s[i]
+}
+
+func (s *SetOfArtifacts) Add(a *Artifact) {
+	*s = append(*s, a)
+	if nb := len(*s); nb > 1 && !sort.IsSorted((*s)[nb-2:]) {
+		sort.Sort(s)
+	}
+}
+
+
+func (s SetOfArtifacts) Less(i, j int) bool {
+	return s[i].ID < s[j].ID
+}
+
+func (s SetOfArtifacts) Check() error {
+	if !sort.IsSorted(s) {	
+		return errors.New("Unsorted")
+	}
+	var lastId string
+	for _, a := range s {
+		if lastId == a.ID {
+			return errors.New("Duplicate ID")
+		}
+		lastId = a.ID
+	}
+	return nil
+}
+
+func (s SetOfArtifacts) Slice(marker string, max uint32) []*Artifact {
+	if max == 0 {
+		max = 1000
+	} else if max > 100000 {
+		max = 100000
+	}
+	start := sort.Search(len(s), func(i int) bool {
+		return s[i].ID > marker
+	})
+	if start < 0 || start >= s.Len() {
+		return s[:0]
+	}
+	remaining := uint32(s.Len() - start)
+	if remaining > max {
+		remaining = max
+	}
+	return s[start : uint32(start)+remaining]
+}
+
+func (s SetOfArtifacts) getIndex(id string) int {
+	i := sort.Search(len(s), func(i int) bool {
+		return s[i].ID >= id
+	})
+	if i < len(s) && s[i].ID == id {
+		return i
+	}
+	return -1
+}
+
+func (s SetOfArtifacts) Get(id string) *Artifact {
+	var out *Artifact
+	idx := s.getIndex(id)
+	if idx >= 0 {
+		out = s[idx]
+	}
+	return out
+}
+
+func (s SetOfArtifacts) Has(id string) bool {
+	return s.getIndex(id) >= 0
+}
+
+func (s *SetOfArtifacts) Remove(a *Artifact) {
+	idx := s.getIndex(a.ID)
+	if idx >= 0 && idx < len(*s) {
+		if len(*s) == 1 {
+			*s = (*s)[:0]
+		} else {
+			s.Swap(idx, s.Len()-1)
+			*s = (*s)[:s.Len()-1]
+			sort.Sort(*s)
+		}
+	}
+}
+
+
+
+type SetOfArtifactTypes []*ArtifactType
+
+func (s SetOfArtifactTypes) CheckThenFail() {
+	if err := s.Check(); err != nil {
+		panic(err.Error())
+	}
+}
+
+func (s SetOfArtifactTypes) Len() int {
+	return len(s)
+}
+
+func (s SetOfArtifactTypes) Swap(i, j int) {
+	s[i], s[j] = s[j], s[i]
+}
+
+func (s *SetOfArtifactTypes) Add(a *ArtifactType) {
+	*s = append(*s, a)
+	if nb := len(*s); nb > 1 && !sort.IsSorted((*s)[nb-2:]) {
+		sort.Sort(s)
+	}
+}
+
+
+func (s SetOfArtifactTypes) Less(i, j int) bool {
+	return s[i].ID < s[j].ID
+}
+
+func (s SetOfArtifactTypes) Check() error {
+	if !sort.IsSorted(s) {	
+		return errors.New("Unsorted")
+	}
+	var lastId uint64
+	for _, a := range s {
+		if lastId == a.ID {
+			return errors.New("Duplicate ID")
+		}
+		lastId = a.ID
+	}
+	return nil
+}
+
+func (s SetOfArtifactTypes) Slice(marker uint64, max uint32) []*ArtifactType {
+	if max == 0 {
+		max = 1000
+	} else if max > 100000 {
+		max = 100000
+	}
+	start := sort.Search(len(s), func(i int) bool {
+		return s[i].ID > marker
+	})
+	if start < 0 || start >= s.Len() {
+		return s[:0]
+	}
+	remaining := uint32(s.Len() - start)
+	if remaining > max {
+		remaining = max
+	}
+	return s[start : uint32(start)+remaining]
+}
+
+func (s SetOfArtifactTypes) getIndex(id uint64) int {
+	i := sort.Search(len(s), func(i int) bool {
+		return s[i].ID >= id
+	})
+	if i < len(s) && s[i].ID == id {
+		return i
+	}
+	return -1
+}
+
+func (s SetOfArtifactTypes) Get(id uint64) *ArtifactType {
+	var out *ArtifactType
+	idx := s.getIndex(id)
+	if idx >= 0 {
+		out = s[idx]
+	}
+	return out
+}
+
+func (s SetOfArtifactTypes) Has(id uint64) bool {
+	return s.getIndex(id) >= 0
+}
+
+func (s *SetOfArtifactTypes) Remove(a *ArtifactType) {
+	idx := s.getIndex(a.ID)
+	if idx >= 0 && idx < len(*s) {
+		if len(*s) == 1 {
+			*s = (*s)[:0]
+		} else {
+			s.Swap(idx, s.Len()-1)
+			*s = (*s)[:s.Len()-1]
+			sort.Sort(*s)
+		}
+	}
+}
+
+
+
+type SetOfArmies []*Army
+
+func (s SetOfArmies) CheckThenFail() {
+	if err := s.Check(); err != nil {
+		panic(err.Error())
+	}
+}
+
+func (s SetOfArmies) Len() int {
+	return len(s)
+}
+
+func (s SetOfArmies) Swap(i, j int) {
+	s[i], s[j] = s[j], s[i]
+}
+
+func (s *SetOfArmies) Add(a *Army) {
+	*s = append(*s, a)
+	if nb := len(*s); nb > 1 && !sort.IsSorted((*s)[nb-2:]) {
+		sort.Sort(s)
+	}
+}
+
+
+func (s SetOfArmies) Less(i, j int) bool {
+	return s[i].ID < s[j].ID
+}
+
+func (s SetOfArmies) Check() error {
+	if !sort.IsSorted(s) {	
+		return errors.New("Unsorted")
+	}
+	var lastId string
+	for _, a := range s {
+		if lastId == a.ID {
+			return errors.New("Duplicate ID")
+		}
+		lastId = a.ID
+	}
+	return nil
+}
+
+func (s SetOfArmies) Slice(marker string, max uint32) []*Army {
+	if max == 0 {
+		max = 1000
+	} else if max > 100000 {
+		max = 100000
+	}
+	start := sort.Search(len(s), func(i int) bool {
+		return s[i].ID > marker
+	})
+	if start < 0 || start >= s.Len() {
+		return s[:0]
+	}
+	remaining := uint32(s.Len() - start)
+	if remaining > max {
+		remaining = max
+	}
+	return s[start : uint32(start)+remaining]
+}
+
+func (s SetOfArmies) getIndex(id string) int {
+	i := sort.Search(len(s), func(i int) bool {
+		return s[i].ID >= id
+	})
+	if i < len(s) && s[i].ID == id {
+		return i
+	}
+	return -1
+}
+
+func (s SetOfArmies) Get(id string) *Army {
+	var out *Army
+	idx := s.getIndex(id)
+	if idx >= 0 {
+		out = s[idx]
+	}
+	return out
+}
+
+func (s SetOfArmies) Has(id string) bool {
+	return s.getIndex(id) >= 0
+}
+
+func (s *SetOfArmies) Remove(a *Army) {
+	idx := s.getIndex(a.ID)
+	if idx >= 0 && idx < len(*s) {
+		if len(*s) == 1 {
+			*s = (*s)[:0]
+		} else {
+			s.Swap(idx, s.Len()-1)
+			*s = (*s)[:s.Len()-1]
+			sort.Sort(*s)
+		}
+	}
+}
+
+
+
+type SetOfBuildings []*Building
+
+func (s SetOfBuildings) CheckThenFail() {
+	if err := s.Check(); err != nil {
+		panic(err.Error())
+	}
+}
+
+func (s SetOfBuildings) Len() int {
+	return len(s)
+}
+
+func (s SetOfBuildings) Swap(i, j int) {
+	s[i], s[j] = s[j], s[i]
+}
+
+func (s *SetOfBuildings) Add(a *Building) {
+	*s = append(*s, a)
+	if nb := len(*s); nb > 1 && !sort.IsSorted((*s)[nb-2:]) {
+		sort.Sort(s)
+	}
+}
+
+
+func (s SetOfBuildings) Less(i, j int) bool {
+	return s[i].ID < s[j].ID
+}
+
+func (s SetOfBuildings) Check() error {
+	if !sort.IsSorted(s) {	
+		return errors.New("Unsorted")
+	}
+	var lastId string
+	for _, a := range s {
+		if lastId == a.ID {
+			return errors.New("Duplicate ID")
+		}
+		lastId = a.ID
+	}
+	return nil
+}
+
+func (s SetOfBuildings) Slice(marker string, max uint32) []*Building {
+	if max == 0 {
+		max = 1000
+	} else if max > 100000 {
+		max = 100000
+	}
+	start := sort.Search(len(s), func(i int) bool {
+		return s[i].ID > marker
+	})
+	if start < 0 || start >= s.Len() {
+		return s[:0]
+	}
+	remaining := uint32(s.Len() - start)
+	if remaining > max {
+		remaining = max
+	}
+	return s[start : uint32(start)+remaining]
+}
+
+func (s SetOfBuildings) getIndex(id string) int {
+	i := sort.Search(len(s), func(i int) bool {
+		return s[i].ID >= id
+	})
+	if i < len(s) && s[i].ID == id {
+		return i
+	}
+	return -1
+}
+
+func (s SetOfBuildings) Get(id string) *Building {
+	var out *Building
+	idx := s.getIndex(id)
+	if idx >= 0 {
+		out = s[idx]
+	}
+	return out
+}
+
+func (s SetOfBuildings) Has(id string) bool {
+	return s.getIndex(id) >= 0
+}
+
+func (s *SetOfBuildings) Remove(a *Building) {
+	idx := s.getIndex(a.ID)
+	if idx >= 0 && idx < len(*s) {
+		if len(*s) == 1 {
+			*s = (*s)[:0]
+		} else {
+			s.Swap(idx, s.Len()-1)
+			*s = (*s)[:s.Len()-1]
+			sort.Sort(*s)
+		}
+	}
+}
+
+
+
+type SetOfBuildingTypes []*BuildingType
+
+func (s SetOfBuildingTypes) CheckThenFail() {
+	if err := s.Check(); err != nil {
+		panic(err.Error())
+	}
+}
+
+func (s SetOfBuildingTypes) Len() int {
+	return len(s)
+}
+
+func (s SetOfBuildingTypes) Swap(i, j int) {
+	s[i], s[j] = s[j], s[i]
+}
+
+func (s *SetOfBuildingTypes) Add(a *BuildingType) {
+	*s = append(*s, a)
+	if nb := len(*s); nb > 1 && !sort.IsSorted((*s)[nb-2:]) {
+		sort.Sort(s)
+	}
+}
+
+
+func (s SetOfBuildingTypes) Less(i, j int) bool {
+	return s[i].ID < s[j].ID
+}
+
+func (s SetOfBuildingTypes) Check() error {
+	if !sort.IsSorted(s) {	
+		return errors.New("Unsorted")
+	}
+	var lastId uint64
+	for _, a := range s {
+		if lastId == a.ID {
+			return errors.New("Duplicate ID")
+		}
+		lastId = a.ID
+	}
+	return nil
+}
+
+func (s SetOfBuildingTypes) Slice(marker uint64, max uint32) []*BuildingType {
+	if max == 0 {
+		max = 1000
+	} else if max > 100000 {
+		max = 100000
+	}
+	start := sort.Search(len(s), func(i int) bool {
+		return s[i].ID > marker
+	})
+	if start < 0 || start >= s.Len() {
+		return s[:0]
+	}
+	remaining := uint32(s.Len() - start)
+	if remaining > max {
+		remaining = max
+	}
+	return s[start : uint32(start)+remaining]
+}
+
+func (s SetOfBuildingTypes) getIndex(id uint64) int {
+	i := sort.Search(len(s), func(i int) bool {
+		return s[i].ID >= id
+	})
+	if i < len(s) && s[i].ID == id {
+		return i
+	}
+	return -1
+}
+
+func (s SetOfBuildingTypes) Get(id uint64) *BuildingType {
+	var out *BuildingType
+	idx := s.getIndex(id)
+	if idx >= 0 {
+		out = s[idx]
+	}
+	return out
+}
+
+func (s SetOfBuildingTypes) Has(id uint64) bool {
+	return s.getIndex(id) >= 0
+}
+
+func (s *SetOfBuildingTypes) Remove(a *BuildingType) {
+	idx := s.getIndex(a.ID)
+	if idx >= 0 && idx < len(*s) {
+		if len(*s) == 1 {
+			*s = (*s)[:0]
+		} else {
+			s.Swap(idx, s.Len()-1)
+			*s = (*s)[:s.Len()-1]
+			sort.Sort(*s)
+		}
+	}
+}
+
+
+
+type SetOfCities []*City
+
+func (s SetOfCities) CheckThenFail() {
+	if err := s.Check(); err != nil {
+		panic(err.Error())
+	}
+}
+
+func (s SetOfCities) Len() int {
+	return len(s)
+}
+
+func (s SetOfCities) Swap(i, j int) {
+	s[i], s[j] = s[j], s[i]
+}
+
+func (s *SetOfCities) Add(a *City) {
+	*s = append(*s, a)
+	if nb := len(*s); nb > 1 && !sort.IsSorted((*s)[nb-2:]) {
+		sort.Sort(s)
+	}
+}
+
+
+func (s SetOfCities) Less(i, j int) bool {
+	return s[i].ID < s[j].ID
+}
+
+func (s SetOfCities) Check() error {
+	if !sort.IsSorted(s) {	
+		return errors.New("Unsorted")
+	}
+	var lastId uint64
+	for _, a := range s {
+		if lastId == a.ID {
+			return errors.New("Duplicate ID")
+		}
+		lastId = a.ID
+	}
+	return nil
+}
+
+func (s SetOfCities) Slice(marker uint64, max uint32) []*City {
+	if max == 0 {
+		max = 1000
+	} else if max > 100000 {
+		max = 100000
+	}
+	start := sort.Search(len(s), func(i int) bool {
+		return s[i].ID > marker
+	})
+	if start < 0 || start >= s.Len() {
+		return s[:0]
+	}
+	remaining := uint32(s.Len() - start)
+	if remaining > max {
+		remaining = max
+	}
+	return s[start : uint32(start)+remaining]
+}
+
+func (s SetOfCities) getIndex(id uint64) int {
+	i := sort.Search(len(s), func(i int) bool {
+		return s[i].ID >= id
+	})
+	if i < len(s) && s[i].ID == id {
+		return i
+	}
+	return -1
+}
+
+func (s SetOfCities) Get(id uint64) *City {
+	var out *City
+	idx := s.getIndex(id)
+	if idx >= 0 {
+		out = s[idx]
+	}
+	return out
+}
+
+func (s SetOfCities) Has(id uint64) bool {
+	return s.getIndex(id) >= 0
+}
+
+func (s *SetOfCities) Remove(a *City) {
+	idx := s.getIndex(a.ID)
+	if idx >= 0 && idx < len(*s) {
+		if len(*s) == 1 {
+			*s = (*s)[:0]
+		} else {
+			s.Swap(idx, s.Len()-1)
+			*s = (*s)[:s.Len()-1]
+			sort.Sort(*s)
+		}
+	}
+}
+
+
+
+type SetOfId []uint64
+
+func (s SetOfId) CheckThenFail() {
+	if err := s.Check(); err != nil {
+		panic(err.Error())
+	}
+}
+
+func (s SetOfId) Len() int {
+	return len(s)
+}
+
+func (s SetOfId) Swap(i, j int) {
+	s[i], s[j] = s[j], s[i]
+}
+
+func (s *SetOfId) Add(a uint64) {
+	*s = append(*s, a)
+	if nb := len(*s); nb > 1 && !sort.IsSorted((*s)[nb-2:]) {
+		sort.Sort(s)
+	}
+}
+
+
+func (s SetOfId) Less(i, j int) bool {
+	return s[i] < s[j]
+}
+
+func (s SetOfId) Check() error {
+	if !sort.IsSorted(s) {	
+		return errors.New("Unsorted")
+	}
+	var lastId uint64
+	for _, a := range s {
+		if lastId == a {
+			return errors.New("Duplicate ID")
+		}
+		lastId = a
+	}
+	return nil
+}
+
+func (s SetOfId) Slice(marker uint64, max uint32) []uint64 {
+	if max == 0 {
+		max = 1000
+	} else if max > 100000 {
+		max = 100000
+	}
+	start := sort.Search(len(s), func(i int) bool {
+		return s[i] > marker
+	})
+	if start < 0 || start >= s.Len() {
+		return s[:0]
+	}
+	remaining := uint32(s.Len() - start)
+	if remaining > max {
+		remaining = max
+	}
+	return s[start : uint32(start)+remaining]
+}
+
+func (s SetOfId) getIndex(id uint64) int {
+	i := sort.Search(len(s), func(i int) bool {
+		return s[i] >= id
+	})
+	if i < len(s) && s[i] == id {
+		return i
+	}
+	return -1
+}
+
+func (s SetOfId) Get(id uint64) uint64 {
+	var out uint64
+	idx := s.getIndex(id)
+	if idx >= 0 {
+		out = s[idx]
+	}
+	return out
+}
+
+func (s SetOfId) Has(id uint64) bool {
+	return s.getIndex(id) >= 0
+}
+
+func (s *SetOfId) Remove(a uint64) {
+	idx := s.getIndex(a)
+	if idx >= 0 && idx < len(*s) {
+		if len(*s) == 1 {
+			*s = (*s)[:0]
+		} else {
+			s.Swap(idx, s.Len()-1)
+			*s = (*s)[:s.Len()-1]
+			sort.Sort(*s)
+		}
+	}
+}
+
+
+
+type SetOfKnowledges []*Knowledge
+
+func (s SetOfKnowledges) CheckThenFail() {
+	if err := s.Check(); err != nil {
+		panic(err.Error())
+	}
+}
+
+func (s SetOfKnowledges) Len() int {
+	return len(s)
+}
+
+func (s SetOfKnowledges) Swap(i, j int) {
+	s[i], s[j] = s[j], s[i]
+}
+
+func (s *SetOfKnowledges) Add(a *Knowledge) {
+	*s = append(*s, a)
+	if nb := len(*s); nb > 1 && !sort.IsSorted((*s)[nb-2:]) {
+		sort.Sort(s)
+	}
+}
+
+
+func (s SetOfKnowledges) Less(i, j int) bool {
+	return s[i].ID < s[j].ID
+}
+
+func (s SetOfKnowledges) Check() error {
+	if !sort.IsSorted(s) {	
+		return errors.New("Unsorted")
+	}
+	var lastId string
+	for _, a := range s {
+		if lastId == a.ID {
+			return errors.New("Duplicate ID")
+		}
+		lastId = a.ID
+	}
+	return nil
+}
+
+func (s SetOfKnowledges) Slice(marker string, max uint32) []*Knowledge {
+	if max == 0 {
+		max = 1000
+	} else if max > 100000 {
+		max = 100000
+	}
+	start := sort.Search(len(s), func(i int) bool {
+		return s[i].ID > marker
+	})
+	if start < 0 || start >= s.Len() {
+		return s[:0]
+	}
+	remaining := uint32(s.Len() - start)
+	if remaining > max {
+		remaining = max
+	}
+	return s[start : uint32(start)+remaining]
+}
+
+func (s SetOfKnowledges) getIndex(id string) int {
+	i := sort.Search(len(s), func(i int) bool {
+		return s[i].ID >= id
+	})
+	if i < len(s) && s[i].ID == id {
+		return i
+	}
+	return -1
+}
+
+func (s SetOfKnowledges) Get(id string) *Knowledge {
+	var out *Knowledge
+	idx := s.getIndex(id)
+	if idx >= 0 {
+		out = s[idx]
+	}
+	return out
+}
+
+func (s SetOfKnowledges) Has(id string) bool {
+	return s.getIndex(id) >= 0
+}
+
+func (s *SetOfKnowledges) Remove(a *Knowledge) {
+	idx := s.getIndex(a.ID)
+	if idx >= 0 && idx < len(*s) {
+		if len(*s) == 1 {
+			*s = (*s)[:0]
+		} else {
+			s.Swap(idx, s.Len()-1)
+			*s = (*s)[:s.Len()-1]
+			sort.Sort(*s)
+		}
+	}
+}
+
+
+
+type SetOfKnowledgeTypes []*KnowledgeType
+
+func (s SetOfKnowledgeTypes) CheckThenFail() {
+	if err := s.Check(); err != nil {
+		panic(err.Error())
+	}
+}
+
+func (s SetOfKnowledgeTypes) Len() int {
+	return len(s)
+}
+
+func (s SetOfKnowledgeTypes) Swap(i, j int) {
+	s[i], s[j] = s[j], s[i]
+}
+
+func (s *SetOfKnowledgeTypes) Add(a *KnowledgeType) {
+	*s = append(*s, a)
+	if nb := len(*s); nb > 1 && !sort.IsSorted((*s)[nb-2:]) {
+		sort.Sort(s)
+	}
+}
+
+
+func (s SetOfKnowledgeTypes) Less(i, j int) bool {
+	return s[i].ID < s[j].ID
+}
+
+func (s SetOfKnowledgeTypes) Check() error {
+	if !sort.IsSorted(s) {	
+		return errors.New("Unsorted")
+	}
+	var lastId uint64
+	for _, a := range s {
+		if lastId == a.ID {
+			return errors.New("Duplicate ID")
+		}
+		lastId = a.ID
+	}
+	return nil
+}
+
+func (s SetOfKnowledgeTypes) Slice(marker uint64, max uint32) []*KnowledgeType {
+	if max == 0 {
+		max = 1000
+	} else if max > 100000 {
+		max = 100000
+	}
+	start := sort.Search(len(s), func(i int) bool {
+		return s[i].ID > marker
+	})
+	if start < 0 || start >= s.Len() {
+		return s[:0]
+	}
+	remaining := uint32(s.Len() - start)
+	if remaining > max {
+		remaining = max
+	}
+	return s[start : uint32(start)+remaining]
+}
+
+func (s SetOfKnowledgeTypes) getIndex(id uint64) int {
+	i := sort.Search(len(s), func(i int) bool {
+		return s[i].ID >= id
+	})
+	if i < len(s) && s[i].ID == id {
+		return i
+	}
+	return -1
+}
+
+func (s SetOfKnowledgeTypes) Get(id uint64) *KnowledgeType {
+	var out *KnowledgeType
+	idx := s.getIndex(id)
+	if idx >= 0 {
+		out = s[idx]
+	}
+	return out
+}
+
+func (s SetOfKnowledgeTypes) Has(id uint64) bool {
+	return s.getIndex(id) >= 0
+}
+
+func (s *SetOfKnowledgeTypes) Remove(a *KnowledgeType) {
+	idx := s.getIndex(a.ID)
+	if idx >= 0 && idx < len(*s) {
+		if len(*s) == 1 {
+			*s = (*s)[:0]
+		} else {
+			s.Swap(idx, s.Len()-1)
+			*s = (*s)[:s.Len()-1]
+			sort.Sort(*s)
+		}
+	}
+}
+
+
+
+type SetOfUnits []*Unit
+
+func (s SetOfUnits) CheckThenFail() {
+	if err := s.Check(); err != nil {
+		panic(err.Error())
+	}
+}
+
+func (s SetOfUnits) Len() int {
+	return len(s)
+}
+
+func (s SetOfUnits) Swap(i, j int) {
+	s[i], s[j] = s[j], s[i]
+}
+
+func (s *SetOfUnits) Add(a *Unit) {
+	*s = append(*s, a)
+	if nb := len(*s); nb > 1 && !sort.IsSorted((*s)[nb-2:]) {
+		sort.Sort(s)
+	}
+}
+
+
+func (s SetOfUnits) Less(i, j int) bool {
+	return s[i].ID < s[j].ID
+}
+
+func (s SetOfUnits) Check() error {
+	if !sort.IsSorted(s) {	
+		return errors.New("Unsorted")
+	}
+	var lastId string
+	for _, a := range s {
+		if lastId == a.ID {
+			return errors.New("Duplicate ID")
+		}
+		lastId = a.ID
+	}
+	return nil
+}
+
+func (s SetOfUnits) Slice(marker string, max uint32) []*Unit {
+	if max == 0 {
+		max = 1000
+	} else if max > 100000 {
+		max = 100000
+	}
+	start := sort.Search(len(s), func(i int) bool {
+		return s[i].ID > marker
+	})
+	if start < 0 || start >= s.Len() {
+		return s[:0]
+	}
+	remaining := uint32(s.Len() - start)
+	if remaining > max {
+		remaining = max
+	}
+	return s[start : uint32(start)+remaining]
+}
+
+func (s SetOfUnits) getIndex(id string) int {
+	i := sort.Search(len(s), func(i int) bool {
+		return s[i].ID >= id
+	})
+	if i < len(s) && s[i].ID == id {
+		return i
+	}
+	return -1
+}
+
+func (s SetOfUnits) Get(id string) *Unit {
+	var out *Unit
+	idx := s.getIndex(id)
+	if idx >= 0 {
+		out = s[idx]
+	}
+	return out
+}
+
+func (s SetOfUnits) Has(id string) bool {
+	return s.getIndex(id) >= 0
+}
+
+func (s *SetOfUnits) Remove(a *Unit) {
+	idx := s.getIndex(a.ID)
+	if idx >= 0 && idx < len(*s) {
+		if len(*s) == 1 {
+			*s = (*s)[:0]
+		} else {
+			s.Swap(idx, s.Len()-1)
+			*s = (*s)[:s.Len()-1]
+			sort.Sort(*s)
+		}
+	}
+}
+
+
+
+type SetOfUnitTypes []*UnitType
+
+func (s SetOfUnitTypes) CheckThenFail() {
+	if err := s.Check(); err != nil {
+		panic(err.Error())
+	}
+}
+
+func (s SetOfUnitTypes) Len() int {
+	return len(s)
+}
+
+func (s SetOfUnitTypes) Swap(i, j int) {
+	s[i], s[j] = s[j], s[i]
+}
+
+func (s *SetOfUnitTypes) Add(a *UnitType) {
+	*s = append(*s, a)
+	if nb := len(*s); nb > 1 && !sort.IsSorted((*s)[nb-2:]) {
+		sort.Sort(s)
+	}
+}
+
+
+func (s SetOfUnitTypes) Less(i, j int) bool {
+	return s[i].ID < s[j].ID
+}
+
+func (s SetOfUnitTypes) Check() error {
+	if !sort.IsSorted(s) {	
+		return errors.New("Unsorted")
+	}
+	var lastId uint64
+	for _, a := range s {
+		if lastId == a.ID {
+			return errors.New("Duplicate ID")
+		}
+		lastId = a.ID
+	}
+	return nil
+}
+
+func (s SetOfUnitTypes) Slice(marker uint64, max uint32) []*UnitType {
+	if max == 0 {
+		max = 1000
+	} else if max > 100000 {
+		max = 100000
+	}
+	start := sort.Search(len(s), func(i int) bool {
+		return s[i].ID > marker
+	})
+	if start < 0 || start >= s.Len() {
+		return s[:0]
+	}
+	remaining := uint32(s.Len() - start)
+	if remaining > max {
+		remaining = max
+	}
+	return s[start : uint32(start)+remaining]
+}
+
+func (s SetOfUnitTypes) getIndex(id uint64) int {
+	i := sort.Search(len(s), func(i int) bool {
+		return s[i].ID >= id
+	})
+	if i < len(s) && s[i].ID == id {
+		return i
+	}
+	return -1
+}
+
+func (s SetOfUnitTypes) Get(id uint64) *UnitType {
+	var out *UnitType
+	idx := s.getIndex(id)
+	if idx >= 0 {
+		out = s[idx]
+	}
+	return out
+}
+
+func (s SetOfUnitTypes) Has(id uint64) bool {
+	return s.getIndex(id) >= 0
+}
+
+func (s *SetOfUnitTypes) Remove(a *UnitType) {
+	idx := s.getIndex(a.ID)
+	if idx >= 0 && idx < len(*s) {
+		if len(*s) == 1 {
+			*s = (*s)[:0]
+		} else {
+			s.Swap(idx, s.Len()-1)
+			*s = (*s)[:s.Len()-1]
+			sort.Sort(*s)
+		}
+	}
+}
+
+
+
+type SetOfRegions []*Region
+
+func (s SetOfRegions) CheckThenFail() {
+	if err := s.Check(); err != nil {
+		panic(err.Error())
+	}
+}
+
+func (s SetOfRegions) Len() int {
+	return len(s)
+}
+
+func (s SetOfRegions) Swap(i, j int) {
+	s[i], s[j] = s[j], s[i]
+}
+
+func (s *SetOfRegions) Add(a *Region) {
+	*s = append(*s, a)
+	if nb := len(*s); nb > 1 && !sort.IsSorted((*s)[nb-2:]) {
+		sort.Sort(s)
+	}
+}
+
+
+func (s SetOfRegions) Less(i, j int) bool {
+	return s[i].Name < s[j].Name
+}
+
+func (s SetOfRegions) Check() error {
+	if !sort.IsSorted(s) {	
+		return errors.New("Unsorted")
+	}
+	var lastId string
+	for _, a := range s {
+		if lastId == a.Name {
+			return errors.New("Duplicate ID")
+		}
+		lastId = a.Name
+	}
+	return nil
+}
+
+func (s SetOfRegions) Slice(marker string, max uint32) []*Region {
+	if max == 0 {
+		max = 1000
+	} else if max > 100000 {
+		max = 100000
+	}
+	start := sort.Search(len(s), func(i int) bool {
+		return s[i].Name > marker
+	})
+	if start < 0 || start >= s.Len() {
+		return s[:0]
+	}
+	remaining := uint32(s.Len() - start)
+	if remaining > max {
+		remaining = max
+	}
+	return s[start : uint32(start)+remaining]
+}
+
+func (s SetOfRegions) getIndex(id string) int {
+	i := sort.Search(len(s), func(i int) bool {
+		return s[i].Name >= id
+	})
+	if i < len(s) && s[i].Name == id {
+		return i
+	}
+	return -1
+}
+
+func (s SetOfRegions) Get(id string) *Region {
+	var out *Region
+	idx := s.getIndex(id)
+	if idx >= 0 {
+		out = s[idx]
+	}
+	return out
+}
+
+func (s SetOfRegions) Has(id string) bool {
+	return s.getIndex(id) >= 0
+}
+
+func (s *SetOfRegions) Remove(a *Region) {
+	idx := s.getIndex(a.Name)
+	if idx >= 0 && idx < len(*s) {
+		if len(*s) == 1 {
+			*s = (*s)[:0]
+		} else {
+			s.Swap(idx, s.Len()-1)
+			*s = (*s)[:s.Len()-1]
+			sort.Sort(*s)
+		}
+	}
+}
+
+
+
+type SetOfTreaties []*Treaty
+
+func (s SetOfTreaties) CheckThenFail() {
+	if err := s.Check(); err != nil {
+		panic(err.Error())
+	}
+}
+
+func (s SetOfTreaties) Len() int {
+	return len(s)
+}
+
+func (s SetOfTreaties) Swap(i, j int) {
+	s[i], s[j] = s[j], s[i]
+}
+
+func (s *SetOfTreaties) Add(a *Treaty) {
+	*s = append(*s, a)
+	if nb := len(*s); nb > 1 && !sort.IsSorted((*s)[nb-2:]) {
+		sort.Sort(s)
+	}
+}
+
+
+func (s SetOfTreaties) Less(i, j int) bool {
+	return s[i].ID < s[j].ID
+}
+
+func (s SetOfTreaties) Check() error {
+	if !sort.IsSorted(s) {	
+		return errors.New("Unsorted")
+	}
+	var lastId string
+	for _, a := range s {
+		if lastId == a.ID {
+			return errors.New("Duplicate ID")
+		}
+		lastId = a.ID
+	}
+	return nil
+}
+
+func (s SetOfTreaties) Slice(marker string, max uint32) []*Treaty {
+	if max == 0 {
+		max = 1000
+	} else if max > 100000 {
+		max = 100000
+	}
+	start := sort.Search(len(s), func(i int) bool {
+		return s[i].ID > marker
+	})
+	if start < 0 || start >= s.Len() {
+		return s[:0]
+	}
+	remaining := uint32(s.Len() - start)
+	if remaining > max {
+		remaining = max
+	}
+	return s[start : uint32(start)+remaining]
+}
+
+func (s SetOfTreaties) getIndex(id string) int {
+	i := sort.Search(len(s), func(i int) bool {
+		return s[i].ID >= id
+	})
+	if i < len(s) && s[i].ID == id {
+		return i
+	}
+	return -1
+}
+
+func (s SetOfTreaties) Get(id string) *Treaty {
+	var out *Treaty
+	idx := s.getIndex(id)
+	if idx >= 0 {
+		out = s[idx]
+	}
+	return out
+}
+
+func (s SetOfTreaties) Has(id string) bool {
+	return s.getIndex(id) >= 0
+}
+
+func (s *SetOfTreaties) Remove(a *Treaty) {
+	idx := s.getIndex(a.ID)
+	if idx >= 0 && idx < len(*s) {
+		if len(*s) == 1 {
+			*s = (*s)[:0]
+		} else {
+			s.Swap(idx, s.Len()-1)
+			*s = (*s)[:s.Len()-1]
+			sort.Sort(*s)
+		}
+	}
+}
+
+
+
+type SetOfOffers []*Offer
+
+func (s SetOfOffers) CheckThenFail() {
+	if err := s.Check(); err != nil {
+		panic(err.Error())
+	}
+}
+
+func (s SetOfOffers) Len() int {
+	return len(s)
+}
+
+func (s SetOfOffers) Swap(i, j int) {
+	s[i], s[j] = s[j], s[i]
+}
+
+func (s *SetOfOffers) Add(a *Offer) {
+	*s = append(*s, a)
+	if nb := len(*s); nb > 1 && !sort.IsSorted((*s)[nb-2:]) {
+		sort.Sort(s)
+	}
+}
+
+
+func (s SetOfOffers) Less(i, j int) bool {
+	return s[i].ID < s[j].ID
+}
+
+func (s SetOfOffers) Check() error {
+	if !sort.IsSorted(s) {	
+		return errors.New("Unsorted")
+	}
+	var lastId string
+	for _, a := range s {
+		if lastId == a.ID {
+			return errors.New("Duplicate ID")
+		}
+		lastId = a.ID
+	}
+	return nil
+}
+
+func (s SetOfOffers) Slice(marker string, max uint32) []*Offer {
+	if max == 0 {
+		max = 1000
+	} else if max > 100000 {
+		max = 100000
+	}
+	start := sort.Search(len(s), func(i int) bool {
+		return s[i].ID > marker
+	})
+	if start < 0 || start >= s.Len() {
+		return s[:0]
+	}
+	remaining := uint32(s.Len() - start)
+	if remaining > max {
+		remaining = max
+	}
+	return s[start : uint32(start)+remaining]
+}
+
+func (s SetOfOffers) getIndex(id string) int {
+	i := sort.Search(len(s), func(i int) bool {
+		return s[i].ID >= id
+	})
+	if i < len(s) && s[i].ID == id {
+		return i
+	}
+	return -1
+}
+
+func (s SetOfOffers) Get(id string) *Offer {
+	var out *Offer
+	idx := s.getIndex(id)
+	if idx >= 0 {
+		out = s[idx]
+	}
+	return out
+}
+
+func (s SetOfOffers) Has(id string) bool {
+	return s.getIndex(id) >= 0
+}
+
+func (s *SetOfOffers) Remove(a *Offer) {
+	idx := s.getIndex(a.ID)
+	if idx >= 0 && idx < len(*s) {
+		if len(*s) == 1 {
+			*s = (*s)[:0]
+		} else {
+			s.Swap(idx, s.Len()-1)
+			*s = (*s)[:s.Len()-1]
+			sort.Sort(*s)
+		}
+	}
+}
+
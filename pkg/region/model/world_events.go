@@ -17,6 +17,11 @@ type Notifier interface {
 	Knowledge(log *City) EventKnowledge
 	// Prepare a notification context to inform :to: of someone hiring troops
 	Units(log *City) EventUnits
+	// Prepare a notification context to inform :to: of an intelligence operation
+	Spy(log *City) EventSpy
+	// Prepare a notification context to inform the Character :to: of a change
+	// in the management of a City.
+	City(to string) EventCity
 }
 
 type EventArmy interface {
@@ -25,6 +30,27 @@ type EventArmy interface {
 	Move(src, dst uint64) EventArmy
 	// Notify the movement is not possible
 	NoRoute(src, dst uint64) EventArmy
+	// Notify the Army fled the Fight happening at the given location
+	Flea(cell uint64) EventArmy
+	// Notify the Army changed its side in the Fight happening at the given location
+	Flip(cell uint64) EventArmy
+	// Notify the Army delivered a part of its content to the City at the given location
+	Deliver(cell uint64, r Resources, nbUnits, nbArtifacts int) EventArmy
+	// Notify the Army has been renamed
+	Rename(prev string) EventArmy
+	Send()
+}
+
+type EventCity interface {
+	Item(c *City) EventCity
+	// Notify the Deputy of the City changed ("" for no Deputy)
+	Deputy(prev, next string) EventCity
+	// Notify the Owner of the City changed
+	Owner(prev, next string) EventCity
+	// Notify the City has been renamed
+	Rename(prev, next string) EventCity
+	// Notify an item in progress has been cancelled, with the refund
+	Cancel(id string, refund Resources) EventCity
 	Send()
 }
 
@@ -40,10 +66,22 @@ type EventUnits interface {
 	Send()
 }
 
+type EventSpy interface {
+	// Identify the City that led the operation and the City that was targeted
+	Item(actor, victim *City) EventSpy
+	// Notify the outcome of an attempt to reveal the City
+	Reveal(success bool) EventSpy
+	// Notify the outcome of an attempt to steal the Knowledge
+	Steal(k *KnowledgeType, success bool) EventSpy
+	Send()
+}
+
 type noEvt struct{}
 type noEvtArmy struct{}
 type noEvtKnowledge struct{}
 type noEvtUnits struct{}
+type noEvtSpy struct{}
+type noEvtCity struct{}
 
 func LogEvent(n Notifier) Notifier {
 	return &eventLogger{sub: n}
@@ -52,12 +90,21 @@ func LogEvent(n Notifier) Notifier {
 func (n *noEvt) Army(to *City) EventArmy           { return &noEvtArmy{} }
 func (n *noEvt) Knowledge(to *City) EventKnowledge { return &noEvtKnowledge{} }
 func (n *noEvt) Units(to *City) EventUnits         { return &noEvtUnits{} }
+func (n *noEvt) Spy(to *City) EventSpy             { return &noEvtSpy{} }
+func (n *noEvt) City(to string) EventCity          { return &noEvtCity{} }
 
 func (ctx *noEvtArmy) Item(a *Army) EventArmy            { return ctx }
 func (ctx *noEvtArmy) Move(src, dst uint64) EventArmy    { return ctx }
 func (ctx *noEvtArmy) NoRoute(src, dst uint64) EventArmy { return ctx }
+func (ctx *noEvtArmy) Flea(cell uint64) EventArmy        { return ctx }
+func (ctx *noEvtArmy) Flip(cell uint64) EventArmy        { return ctx }
+func (ctx *noEvtArmy) Rename(prev string) EventArmy      { return ctx }
 func (ctx *noEvtArmy) Send()                             {}
 
+func (ctx *noEvtArmy) Deliver(cell uint64, r Resources, nbUnits, nbArtifacts int) EventArmy {
+	return ctx
+}
+
 func (ctx *noEvtKnowledge) Item(c *City, k *KnowledgeType) EventKnowledge { return ctx }
 func (ctx *noEvtKnowledge) Step(current, max uint64) EventKnowledge       { return ctx }
 func (ctx *noEvtKnowledge) Send()                                         {}
@@ -66,6 +113,18 @@ func (ctx *noEvtUnits) Item(c *City, k *UnitType) EventUnits { return ctx }
 func (ctx *noEvtUnits) Step(current, max uint64) EventUnits  { return ctx }
 func (ctx *noEvtUnits) Send()                                {}
 
+func (ctx *noEvtSpy) Item(actor, victim *City) EventSpy             { return ctx }
+func (ctx *noEvtSpy) Reveal(success bool) EventSpy                  { return ctx }
+func (ctx *noEvtSpy) Steal(k *KnowledgeType, success bool) EventSpy { return ctx }
+func (ctx *noEvtSpy) Send()                                         {}
+
+func (ctx *noEvtCity) Item(c *City) EventCity                  { return ctx }
+func (ctx *noEvtCity) Deputy(prev, next string) EventCity      { return ctx }
+func (ctx *noEvtCity) Owner(prev, next string) EventCity       { return ctx }
+func (ctx *noEvtCity) Rename(prev, next string) EventCity      { return ctx }
+func (ctx *noEvtCity) Cancel(id string, r Resources) EventCity { return ctx }
+func (ctx *noEvtCity) Send()                                   {}
+
 type eventLogger struct {
 	sub Notifier
 }
@@ -85,6 +144,16 @@ type logEvtUnits struct {
 	sub EventUnits
 }
 
+type logEvtSpy struct {
+	log *zerolog.Event
+	sub EventSpy
+}
+
+type logEvtCity struct {
+	log *zerolog.Event
+	sub EventCity
+}
+
 func logger(to *City) *zerolog.Event {
 	return utils.Logger.Info().
 		Str("logChar", to.Owner).
@@ -103,6 +172,14 @@ func (n *eventLogger) Units(to *City) EventUnits {
 	return &logEvtUnits{log: logger(to), sub: n.sub.Units(to)}
 }
 
+func (n *eventLogger) Spy(to *City) EventSpy {
+	return &logEvtSpy{log: logger(to), sub: n.sub.Spy(to)}
+}
+
+func (n *eventLogger) City(to string) EventCity {
+	return &logEvtCity{log: utils.Logger.Info().Str("logChar", to), sub: n.sub.City(to)}
+}
+
 func (evt *logEvtArmy) Item(a *Army) EventArmy {
 	evt.sub.Item(a)
 	evt.log.Str("army", a.ID)
@@ -121,6 +198,31 @@ func (evt *logEvtArmy) NoRoute(src, dst uint64) EventArmy {
 	return evt
 }
 
+func (evt *logEvtArmy) Flea(cell uint64) EventArmy {
+	evt.sub.Flea(cell)
+	evt.log.Str("action", "flea").Uint64("cell", cell)
+	return evt
+}
+
+func (evt *logEvtArmy) Flip(cell uint64) EventArmy {
+	evt.sub.Flip(cell)
+	evt.log.Str("action", "flip").Uint64("cell", cell)
+	return evt
+}
+
+func (evt *logEvtArmy) Deliver(cell uint64, r Resources, nbUnits, nbArtifacts int) EventArmy {
+	evt.sub.Deliver(cell, r, nbUnits, nbArtifacts)
+	evt.log.Str("action", "deliver").Uint64("cell", cell).
+		Interface("res", r).Int("units", nbUnits).Int("artifacts", nbArtifacts)
+	return evt
+}
+
+func (evt *logEvtArmy) Rename(prev string) EventArmy {
+	evt.sub.Rename(prev)
+	evt.log.Str("action", "rename").Str("prev", prev)
+	return evt
+}
+
 func (evt *logEvtArmy) Send() {
 	evt.sub.Send()
 	evt.log.Send()
@@ -159,3 +261,61 @@ func (evt *logEvtUnits) Send() {
 	evt.sub.Send()
 	evt.log.Send()
 }
+
+func (evt *logEvtSpy) Item(actor, victim *City) EventSpy {
+	evt.sub.Item(actor, victim)
+	evt.log.Uint64("actor", actor.ID).Uint64("victim", victim.ID)
+	return evt
+}
+
+func (evt *logEvtSpy) Reveal(success bool) EventSpy {
+	evt.sub.Reveal(success)
+	evt.log.Str("action", "reveal").Bool("ok", success)
+	return evt
+}
+
+func (evt *logEvtSpy) Steal(k *KnowledgeType, success bool) EventSpy {
+	evt.sub.Steal(k, success)
+	evt.log.Str("action", "steal").Uint64("id", k.ID).Bool("ok", success)
+	return evt
+}
+
+func (evt *logEvtSpy) Send() {
+	evt.sub.Send()
+	evt.log.Send()
+}
+
+func (evt *logEvtCity) Item(c *City) EventCity {
+	evt.sub.Item(c)
+	evt.log.Uint64("city", c.ID)
+	return evt
+}
+
+func (evt *logEvtCity) Deputy(prev, next string) EventCity {
+	evt.sub.Deputy(prev, next)
+	evt.log.Str("action", "deputy").Str("prev", prev).Str("next", next)
+	return evt
+}
+
+func (evt *logEvtCity) Owner(prev, next string) EventCity {
+	evt.sub.Owner(prev, next)
+	evt.log.Str("action", "owner").Str("prev", prev).Str("next", next)
+	return evt
+}
+
+func (evt *logEvtCity) Rename(prev, next string) EventCity {
+	evt.sub.Rename(prev, next)
+	evt.log.Str("action", "rename").Str("prev", prev).Str("next", next)
+	return evt
+}
+
+func (evt *logEvtCity) Cancel(id string, r Resources) EventCity {
+	evt.sub.Cancel(id, r)
+	evt.log.Str("action", "cancel").Str("id", id)
+	return evt
+}
+
+func (evt *logEvtCity) Send() {
+	evt.sub.Send()
+	evt.log.Send()
+}
@@ -0,0 +1,8142 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: region.proto
+
+package proto
+
+import (
+	context "context"
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+type ArmyCommandType int32
+
+const (
+	// A value that should not be encountered.
+	ArmyCommandType_Unknown ArmyCommandType = 0
+	// Move to the location, disband the army and transfer the units to the
+	// local city, if any
+	ArmyCommandType_Disband ArmyCommandType = 1
+	// Move to the location and hold the position. Like 'Move' but the
+	// Useful to let the attitude play and enter attack/defense on local fights
+	ArmyCommandType_Wait ArmyCommandType = 2
+	// Move to the location. The command expires at the arrival at the position.
+	// Useful to let the attitude play and enter attack/defense on local fights
+	ArmyCommandType_Move ArmyCommandType = 3
+	// Move to the location and join the attack of the city
+	ArmyCommandType_Attack ArmyCommandType = 4
+	// Move to the location and join the defense of the local city
+	ArmyCommandType_Defend ArmyCommandType = 5
+)
+
+var ArmyCommandType_name = map[int32]string{
+	0: "Unknown",
+	1: "Disband",
+	2: "Wait",
+	3: "Move",
+	4: "Attack",
+	5: "Defend",
+}
+
+var ArmyCommandType_value = map[string]int32{
+	"Unknown": 0,
+	"Disband": 1,
+	"Wait":    2,
+	"Move":    3,
+	"Attack":  4,
+	"Defend":  5,
+}
+
+func (x ArmyCommandType) String() string {
+	return proto.EnumName(ArmyCommandType_name, int32(x))
+}
+
+func (ArmyCommandType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_6eef30384a8831dd, []int{0}
+}
+
+type ClaimStrategy int32
+
+const (
+	// Follow the strategy configured in the Region
+	ClaimStrategy_ClaimDefault ClaimStrategy = 0
+	// Any free City
+	ClaimStrategy_ClaimRandom ClaimStrategy = 1
+	// The free City the farthest from the Cities already owned
+	ClaimStrategy_ClaimFarthest ClaimStrategy = 2
+	// The free City at the given cell
+	ClaimStrategy_ClaimCell ClaimStrategy = 3
+)
+
+var ClaimStrategy_name = map[int32]string{
+	0: "ClaimDefault",
+	1: "ClaimRandom",
+	2: "ClaimFarthest",
+	3: "ClaimCell",
+}
+
+var ClaimStrategy_value = map[string]int32{
+	"ClaimDefault":  0,
+	"ClaimRandom":   1,
+	"ClaimFarthest": 2,
+	"ClaimCell":     3,
+}
+
+func (x ClaimStrategy) String() string {
+	return proto.EnumName(ClaimStrategy_name, int32(x))
+}
+
+func (ClaimStrategy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_6eef30384a8831dd, []int{1}
+}
+
+type TreatyKind int32
+
+const (
+	// A value that should not be encountered.
+	TreatyKind_NoTreaty TreatyKind = 0
+	// The armies of both parties never fight each other, and without any
+	// explicit posture they join the defense of the cities of the other party.
+	TreatyKind_Alliance TreatyKind = 1
+	// The armies of both parties never assault each other
+	TreatyKind_NonAggression TreatyKind = 2
+	// Both parties agree to trade: the offers they accept from each other on
+	// the market are delivered instantly.
+	TreatyKind_Trade TreatyKind = 3
+	// Unilaterally declared
+	TreatyKind_War TreatyKind = 4
+)
+
+var TreatyKind_name = map[int32]string{
+	0: "NoTreaty",
+	1: "Alliance",
+	2: "NonAggression",
+	3: "Trade",
+	4: "War",
+}
+
+var TreatyKind_value = map[string]int32{
+	"NoTreaty":      0,
+	"Alliance":      1,
+	"NonAggression": 2,
+	"Trade":         3,
+	"War":           4,
+}
+
+func (x TreatyKind) String() string {
+	return proto.EnumName(TreatyKind_name, int32(x))
+}
+
+func (TreatyKind) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_6eef30384a8831dd, []int{2}
+}
+
+type None struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *None) Reset()         { *m = None{} }
+func (m *None) String() string { return proto.CompactTextString(m) }
+func (*None) ProtoMessage()    {}
+func (*None) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6eef30384a8831dd, []int{0}
+}
+
+func (m *None) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_None.Unmarshal(m, b)
+}
+func (m *None) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_None.Marshal(b, m, deterministic)
+}
+func (m *None) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_None.Merge(m, src)
+}
+func (m *None) XXX_Size() int {
+	return xxx_messageInfo_None.Size(m)
+}
+func (m *None) XXX_DiscardUnknown() {
+	xxx_messageInfo_None.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_None proto.InternalMessageInfo
+
+type RegionId struct {
+	Region               string   `protobuf:"bytes,1,opt,name=region,proto3" json:"region,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RegionId) Reset()         { *m = RegionId{} }
+func (m *RegionId) String() string { return proto.CompactTextString(m) }
+func (*RegionId) ProtoMessage()    {}
+func (*RegionId) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6eef30384a8831dd, []int{1}
+}
+
+func (m *RegionId) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RegionId.Unmarshal(m, b)
+}
+func (m *RegionId) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RegionId.Marshal(b, m, deterministic)
+}
+func (m *RegionId) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegionId.Merge(m, src)
+}
+func (m *RegionId) XXX_Size() int {
+	return xxx_messageInfo_RegionId.Size(m)
+}
+func (m *RegionId) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegionId.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RegionId proto.InternalMessageInfo
+
+func (m *RegionId) GetRegion() string {
+	if m != nil {
+		return m.Region
+	}
+	return ""
+}
+
+type RegionCreateReq struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	MapName              string   `protobuf:"bytes,2,opt,name=mapName,proto3" json:"mapName,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RegionCreateReq) Reset()         { *m = RegionCreateReq{} }
+func (m *RegionCreateReq) String() string { return proto.CompactTextString(m) }
+func (*RegionCreateReq) ProtoMessage()    {}
+func (*RegionCreateReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6eef30384a8831dd, []int{2}
+}
+
+func (m *RegionCreateReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RegionCreateReq.Unmarshal(m, b)
+}
+func (m *RegionCreateReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RegionCreateReq.Marshal(b, m, deterministic)
+}
+func (m *RegionCreateReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegionCreateReq.Merge(m, src)
+}
+func (m *RegionCreateReq) XXX_Size() int {
+	return xxx_messageInfo_RegionCreateReq.Size(m)
+}
+func (m *RegionCreateReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegionCreateReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RegionCreateReq proto.InternalMessageInfo
+
+func (m *RegionCreateReq) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *RegionCreateReq) GetMapName() string {
+	if m != nil {
+		return m.MapName
+	}
+	return ""
+}
+
+type ScheduleReq struct {
+	Region string `protobuf:"bytes,1,opt,name=region,proto3" json:"region,omitempty"`
+	// Period between two movement rounds, in seconds. 0 disables them.
+	MovePeriod uint32 `protobuf:"varint,2,opt,name=movePeriod,proto3" json:"movePeriod,omitempty"`
+	// Period between two production rounds, in seconds. 0 disables them.
+	ProducePeriod        uint32   `protobuf:"varint,3,opt,name=producePeriod,proto3" json:"producePeriod,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ScheduleReq) Reset()         { *m = ScheduleReq{} }
+func (m *ScheduleReq) String() string { return proto.CompactTextString(m) }
+func (*ScheduleReq) ProtoMessage()    {}
+func (*ScheduleReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6eef30384a8831dd, []int{3}
+}
+
+func (m *ScheduleReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ScheduleReq.Unmarshal(m, b)
+}
+func (m *ScheduleReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ScheduleReq.Marshal(b, m, deterministic)
+}
+func (m *ScheduleReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduleReq.Merge(m, src)
+}
+func (m *ScheduleReq) XXX_Size() int {
+	return xxx_messageInfo_ScheduleReq.Size(m)
+}
+func (m *ScheduleReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduleReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduleReq proto.InternalMessageInfo
+
+func (m *ScheduleReq) GetRegion() string {
+	if m != nil {
+		return m.Region
+	}
+	return ""
+}
+
+func (m *ScheduleReq) GetMovePeriod() uint32 {
+	if m != nil {
+		return m.MovePeriod
+	}
+	return 0
+}
+
+func (m *ScheduleReq) GetProducePeriod() uint32 {
+	if m != nil {
+		return m.ProducePeriod
+	}
+	return 0
+}
+
+type ScheduleView struct {
+	MovePeriod    uint32 `protobuf:"varint,1,opt,name=movePeriod,proto3" json:"movePeriod,omitempty"`
+	ProducePeriod uint32 `protobuf:"varint,2,opt,name=producePeriod,proto3" json:"producePeriod,omitempty"`
+	Paused        bool   `protobuf:"varint,3,opt,name=paused,proto3" json:"paused,omitempty"`
+	// How many rounds have been played since the creation of the Region
+	MoveTicks    uint64 `protobuf:"varint,4,opt,name=moveTicks,proto3" json:"moveTicks,omitempty"`
+	ProduceTicks uint64 `protobuf:"varint,5,opt,name=produceTicks,proto3" json:"produceTicks,omitempty"`
+	// UNIX timestamps of the last rounds played
+	LastMove             int64    `protobuf:"varint,6,opt,name=lastMove,proto3" json:"lastMove,omitempty"`
+	LastProduce          int64    `protobuf:"varint,7,opt,name=lastProduce,proto3" json:"lastProduce,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ScheduleView) Reset()         { *m = ScheduleView{} }
+func (m *ScheduleView) String() string { return proto.CompactTextString(m) }
+func (*ScheduleView) ProtoMessage()    {}
+func (*ScheduleView) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6eef30384a8831dd, []int{4}
+}
+
+func (m *ScheduleView) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ScheduleView.Unmarshal(m, b)
+}
+func (m *ScheduleView) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ScheduleView.Marshal(b, m, deterministic)
+}
+func (m *ScheduleView) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduleView.Merge(m, src)
+}
+func (m *ScheduleView) XXX_Size() int {
+	return xxx_messageInfo_ScheduleView.Size(m)
+}
+func (m *ScheduleView) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduleView.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduleView proto.InternalMessageInfo
+
+func (m *ScheduleView) GetMovePeriod() uint32 {
+	if m != nil {
+		return m.MovePeriod
+	}
+	return 0
+}
+
+func (m *ScheduleView) GetProducePeriod() uint32 {
+	if m != nil {
+		return m.ProducePeriod
+	}
+	return 0
+}
+
+func (m *ScheduleView) GetPaused() bool {
+	if m != nil {
+		return m.Paused
+	}
+	return false
+}
+
+func (m *ScheduleView) GetMoveTicks() uint64 {
+	if m != nil {
+		return m.MoveTicks
+	}
+	return 0
+}
+
+func (m *ScheduleView) GetProduceTicks() uint64 {
+	if m != nil {
+		return m.ProduceTicks
+	}
+	return 0
+}
+
+func (m *ScheduleView) GetLastMove() int64 {
+	if m != nil {
+		return m.LastMove
+	}
+	return 0
+}
+
+func (m *ScheduleView) GetLastProduce() int64 {
+	if m != nil {
+		return m.LastProduce
+	}
+	return 0
+}
+
+type NamedItem struct {
+	Id                   uint64   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *NamedItem) Reset()         { *m = NamedItem{} }
+func (m *NamedItem) String() string { return proto.CompactTextString(m) }
+func (*NamedItem) ProtoMessage()    {}
+func (*NamedItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6eef30384a8831dd, []int{5}
+}
+
+func (m *NamedItem) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NamedItem.Unmarshal(m, b)
+}
+func (m *NamedItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NamedItem.Marshal(b, m, deterministic)
+}
+func (m *NamedItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NamedItem.Merge(m, src)
+}
+func (m *NamedItem) XXX_Size() int {
+	return xxx_messageInfo_NamedItem.Size(m)
+}
+func (m *NamedItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_NamedItem.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NamedItem proto.InternalMessageInfo
+
+func (m *NamedItem) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *NamedItem) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+// Identifies an army managed by the city
+type ArmyId struct {
+	Region               string   `protobuf:"bytes,1,opt,name=region,proto3" json:"region,omitempty"`
+	Character            string   `protobuf:"bytes,2,opt,name=character,proto3" json:"character,omitempty"`
+	City                 uint64   `protobuf:"varint,3,opt,name=city,proto3" json:"city,omitempty"`
+	Army                 uint64   `protobuf:"varint,4,opt,name=army,proto3" json:"army,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ArmyId) Reset()         { *m = ArmyId{} }
+func (m *ArmyId) String() string { return proto.CompactTextString(m) }
+func (*ArmyId) ProtoMessage()    {}
+func (*ArmyId) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6eef30384a8831dd, []int{6}
+}
+
+func (m *ArmyId) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ArmyId.Unmarshal(m, b)
+}
+func (m *ArmyId) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ArmyId.Marshal(b, m, deterministic)
+}
+func (m *ArmyId) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArmyId.Merge(m, src)
+}
+func (m *ArmyId) XXX_Size() int {
+	return xxx_messageInfo_ArmyId.Size(m)
+}
+func (m *ArmyId) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArmyId.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ArmyId proto.InternalMessageInfo
+
+func (m *ArmyId) GetRegion() string {
+	if m != nil {
+		return m.Region
+	}
+	return ""
+}
+
+func (m *ArmyId) GetCharacter() string {
+	if m != nil {
+		return m.Character
+	}
+	return ""
+}
+
+func (m *ArmyId) GetCity() uint64 {
+	if m != nil {
+		return m.City
+	}
+	return 0
+}
+
+func (m *ArmyId) GetArmy() uint64 {
+	if m != nil {
+		return m.Army
+	}
+	return 0
+}
+
+type ArmyView struct {
+	Id        uint64         `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name      string         `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Location  uint64         `protobuf:"varint,3,opt,name=location,proto3" json:"location,omitempty"`
+	Stock     *ResourcesAbs  `protobuf:"bytes,4,opt,name=stock,proto3" json:"stock,omitempty"`
+	Units     []*UnitView    `protobuf:"bytes,5,rep,name=units,proto3" json:"units,omitempty"`
+	Commands  []*ArmyCommand `protobuf:"bytes,6,rep,name=commands,proto3" json:"commands,omitempty"`
+	Postures  []*ArmyPosture `protobuf:"bytes,7,rep,name=postures,proto3" json:"postures,omitempty"`
+	Artifacts []*Artifact    `protobuf:"bytes,8,rep,name=artifacts,proto3" json:"artifacts,omitempty"`
+	// How many rounds the epidemic will still last in the Army
+	TickEpidemic         uint32   `protobuf:"varint,9,opt,name=tickEpidemic,proto3" json:"tickEpidemic,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ArmyView) Reset()         { *m = ArmyView{} }
+func (m *ArmyView) String() string { return proto.CompactTextString(m) }
+func (*ArmyView) ProtoMessage()    {}
+func (*ArmyView) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6eef30384a8831dd, []int{7}
+}
+
+func (m *ArmyView) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ArmyView.Unmarshal(m, b)
+}
+func (m *ArmyView) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ArmyView.Marshal(b, m, deterministic)
+}
+func (m *ArmyView) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArmyView.Merge(m, src)
+}
+func (m *ArmyView) XXX_Size() int {
+	return xxx_messageInfo_ArmyView.Size(m)
+}
+func (m *ArmyView) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArmyView.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ArmyView proto.InternalMessageInfo
+
+func (m *ArmyView) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *ArmyView) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ArmyView) GetLocation() uint64 {
+	if m != nil {
+		return m.Location
+	}
+	return 0
+}
+
+func (m *ArmyView) GetStock() *ResourcesAbs {
+	if m != nil {
+		return m.Stock
+	}
+	return nil
+}
+
+func (m *ArmyView) GetUnits() []*UnitView {
+	if m != nil {
+		return m.Units
+	}
+	return nil
+}
+
+func (m *ArmyView) GetCommands() []*ArmyCommand {
+	if m != nil {
+		return m.Commands
+	}
+	return nil
+}
+
+func (m *ArmyView) GetPostures() []*ArmyPosture {
+	if m != nil {
+		return m.Postures
+	}
+	return nil
+}
+
+func (m *ArmyView) GetArtifacts() []*Artifact {
+	if m != nil {
+		return m.Artifacts
+	}
+	return nil
+}
+
+func (m *ArmyView) GetTickEpidemic() uint32 {
+	if m != nil {
+		return m.TickEpidemic
+	}
+	return 0
+}
+
+type ArmyRenameReq struct {
+	Id                   *ArmyId  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ArmyRenameReq) Reset()         { *m = ArmyRenameReq{} }
+func (m *ArmyRenameReq) String() string { return proto.CompactTextString(m) }
+func (*ArmyRenameReq) ProtoMessage()    {}
+func (*ArmyRenameReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6eef30384a8831dd, []int{8}
+}
+
+func (m *ArmyRenameReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ArmyRenameReq.Unmarshal(m, b)
+}
+func (m *ArmyRenameReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ArmyRenameReq.Marshal(b, m, deterministic)
+}
+func (m *ArmyRenameReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArmyRenameReq.Merge(m, src)
+}
+func (m *ArmyRenameReq) XXX_Size() int {
+	return xxx_messageInfo_ArmyRenameReq.Size(m)
+}
+func (m *ArmyRenameReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArmyRenameReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ArmyRenameReq proto.InternalMessageInfo
+
+func (m *ArmyRenameReq) GetId() *ArmyId {
+	if m != nil {
+		return m.Id
+	}
+	return nil
+}
+
+func (m *ArmyRenameReq) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+type ArmyArtifactReq struct {
+	Id                   *ArmyId  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Artifact             string   `protobuf:"bytes,2,opt,name=artifact,proto3" json:"artifact,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ArmyArtifactReq) Reset()         { *m = ArmyArtifactReq{} }
+func (m *ArmyArtifactReq) String() string { return proto.CompactTextString(m) }
+func (*ArmyArtifactReq) ProtoMessage()    {}
+func (*ArmyArtifactReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6eef30384a8831dd, []int{9}
+}
+
+func (m *ArmyArtifactReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ArmyArtifactReq.Unmarshal(m, b)
+}
+func (m *ArmyArtifactReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ArmyArtifactReq.Marshal(b, m, deterministic)
+}
+func (m *ArmyArtifactReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArmyArtifactReq.Merge(m, src)
+}
+func (m *ArmyArtifactReq) XXX_Size() int {
+	return xxx_messageInfo_ArmyArtifactReq.Size(m)
+}
+func (m *ArmyArtifactReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArmyArtifactReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ArmyArtifactReq proto.InternalMessageInfo
+
+func (m *ArmyArtifactReq) GetId() *ArmyId {
+	if m != nil {
+		return m.Id
+	}
+	return nil
+}
+
+func (m *ArmyArtifactReq) GetArtifact() string {
+	if m != nil {
+		return m.Artifact
+	}
+	return ""
+}
+
+// A positive value means "defend", a negative value means "assault".
+// Only one of 'city' and 'character' is set.
+type ArmyPosture struct {
+	City                 uint64   `protobuf:"varint,1,opt,name=city,proto3" json:"city,omitempty"`
+	Character            string   `protobuf:"bytes,2,opt,name=character,proto3" json:"character,omitempty"`
+	Value                int64    `protobuf:"varint,3,opt,name=value,proto3" json:"value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ArmyPosture) Reset()         { *m = ArmyPosture{} }
+func (m *ArmyPosture) String() string { return proto.CompactTextString(m) }
+func (*ArmyPosture) ProtoMessage()    {}
+func (*ArmyPosture) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6eef30384a8831dd, []int{10}
+}
+
+func (m *ArmyPosture) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ArmyPosture.Unmarshal(m, b)
+}
+func (m *ArmyPosture) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ArmyPosture.Marshal(b, m, deterministic)
+}
+func (m *ArmyPosture) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArmyPosture.Merge(m, src)
+}
+func (m *ArmyPosture) XXX_Size() int {
+	return xxx_messageInfo_ArmyPosture.Size(m)
+}
+func (m *ArmyPosture) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArmyPosture.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ArmyPosture proto.InternalMessageInfo
+
+func (m *ArmyPosture) GetCity() uint64 {
+	if m != nil {
+		return m.City
+	}
+	return 0
+}
+
+func (m *ArmyPosture) GetCharacter() string {
+	if m != nil {
+		return m.Character
+	}
+	return ""
+}
+
+func (m *ArmyPosture) GetValue() int64 {
+	if m != nil {
+		return m.Value
+	}
+	return 0
+}
+
+type ArmyPostureCityReq struct {
+	Id   *ArmyId `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	City uint64  `protobuf:"varint,2,opt,name=city,proto3" json:"city,omitempty"`
+	// A positive value means "defend", a negative value means "assault",
+	// 0 resets the posture.
+	Value                int64    `protobuf:"varint,3,opt,name=value,proto3" json:"value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ArmyPostureCityReq) Reset()         { *m = ArmyPostureCityReq{} }
+func (m *ArmyPostureCityReq) String() string { return proto.CompactTextString(m) }
+func (*ArmyPostureCityReq) ProtoMessage()    {}
+func (*ArmyPostureCityReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6eef30384a8831dd, []int{11}
+}
+
+func (m *ArmyPostureCityReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ArmyPostureCityReq.Unmarshal(m, b)
+}
+func (m *ArmyPostureCityReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ArmyPostureCityReq.Marshal(b, m, deterministic)
+}
+func (m *ArmyPostureCityReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArmyPostureCityReq.Merge(m, src)
+}
+func (m *ArmyPostureCityReq) XXX_Size() int {
+	return xxx_messageInfo_ArmyPostureCityReq.Size(m)
+}
+func (m *ArmyPostureCityReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArmyPostureCityReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ArmyPostureCityReq proto.InternalMessageInfo
+
+func (m *ArmyPostureCityReq) GetId() *ArmyId {
+	if m != nil {
+		return m.Id
+	}
+	return nil
+}
+
+func (m *ArmyPostureCityReq) GetCity() uint64 {
+	if m != nil {
+		return m.City
+	}
+	return 0
+}
+
+func (m *ArmyPostureCityReq) GetValue() int64 {
+	if m != nil {
+		return m.Value
+	}
+	return 0
+}
+
+type ArmyPostureCharacterReq struct {
+	Id        *ArmyId `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Character string  `protobuf:"bytes,2,opt,name=character,proto3" json:"character,omitempty"`
+	// A positive value means "defend", a negative value means "assault",
+	// 0 resets the posture.
+	Value                int64    `protobuf:"varint,3,opt,name=value,proto3" json:"value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ArmyPostureCharacterReq) Reset()         { *m = ArmyPostureCharacterReq{} }
+func (m *ArmyPostureCharacterReq) String() string { return proto.CompactTextString(m) }
+func (*ArmyPostureCharacterReq) ProtoMessage()    {}
+func (*ArmyPostureCharacterReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6eef30384a8831dd, []int{12}
+}
+
+func (m *ArmyPostureCharacterReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ArmyPostureCharacterReq.Unmarshal(m, b)
+}
+func (m *ArmyPostureCharacterReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ArmyPostureCharacterReq.Marshal(b, m, deterministic)
+}
+func (m *ArmyPostureCharacterReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArmyPostureCharacterReq.Merge(m, src)
+}
+func (m *ArmyPostureCharacterReq) XXX_Size() int {
+	return xxx_messageInfo_ArmyPostureCharacterReq.Size(m)
+}
+func (m *ArmyPostureCharacterReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArmyPostureCharacterReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ArmyPostureCharacterReq proto.InternalMessageInfo
+
+func (m *ArmyPostureCharacterReq) GetId() *ArmyId {
+	if m != nil {
+		return m.Id
+	}
+	return nil
+}
+
+func (m *ArmyPostureCharacterReq) GetCharacter() string {
+	if m != nil {
+		return m.Character
+	}
+	return ""
+}
+
+func (m *ArmyPostureCharacterReq) GetValue() int64 {
+	if m != nil {
+		return m.Value
+	}
+	return 0
+}
+
+type ArmyMoveReq struct {
+	Id     *ArmyId `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Target uint64  `protobuf:"varint,2,opt,name=target,proto3" json:"target,omitempty"`
+	// What to do at the destination
+	Args                 *ArmyMoveArgs `protobuf:"bytes,3,opt,name=args,proto3" json:"args,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *ArmyMoveReq) Reset()         { *m = ArmyMoveReq{} }
+func (m *ArmyMoveReq) String() string { return proto.CompactTextString(m) }
+func (*ArmyMoveReq) ProtoMessage()    {}
+func (*ArmyMoveReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6eef30384a8831dd, []int{13}
+}
+
+func (m *ArmyMoveReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ArmyMoveReq.Unmarshal(m, b)
+}
+func (m *ArmyMoveReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ArmyMoveReq.Marshal(b, m, deterministic)
+}
+func (m *ArmyMoveReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArmyMoveReq.Merge(m, src)
+}
+func (m *ArmyMoveReq) XXX_Size() int {
+	return xxx_messageInfo_ArmyMoveReq.Size(m)
+}
+func (m *ArmyMoveReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArmyMoveReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ArmyMoveReq proto.InternalMessageInfo
+
+func (m *ArmyMoveReq) GetId() *ArmyId {
+	if m != nil {
+		return m.Id
+	}
+	return nil
+}
+
+func (m *ArmyMoveReq) GetTarget() uint64 {
+	if m != nil {
+		return m.Target
+	}
+	return 0
+}
+
+func (m *ArmyMoveReq) GetArgs() *ArmyMoveArgs {
+	if m != nil {
+		return m.Args
+	}
+	return nil
+}
+
+type ArmyMoveArgs struct {
+	// Resources to be given to the local City
+	Stock *ResourcesAbs `protobuf:"bytes,1,opt,name=stock,proto3" json:"stock,omitempty"`
+	// Artifacts to be dropped
+	Artifacts []string `protobuf:"bytes,4,rep,name=artifacts,proto3" json:"artifacts,omitempty"`
+	// Units to be transferred to the local City
+	Units                []string `protobuf:"bytes,5,rep,name=units,proto3" json:"units,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ArmyMoveArgs) Reset()         { *m = ArmyMoveArgs{} }
+func (m *ArmyMoveArgs) String() string { return proto.CompactTextString(m) }
+func (*ArmyMoveArgs) ProtoMessage()    {}
+func (*ArmyMoveArgs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6eef30384a8831dd, []int{14}
+}
+
+func (m *ArmyMoveArgs) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ArmyMoveArgs.Unmarshal(m, b)
+}
+func (m *ArmyMoveArgs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ArmyMoveArgs.Marshal(b, m, deterministic)
+}
+func (m *ArmyMoveArgs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArmyMoveArgs.Merge(m, src)
+}
+func (m *ArmyMoveArgs) XXX_Size() int {
+	return xxx_messageInfo_ArmyMoveArgs.Size(m)
+}
+func (m *ArmyMoveArgs) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArmyMoveArgs.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ArmyMoveArgs proto.InternalMessageInfo
+
+func (m *ArmyMoveArgs) GetStock() *ResourcesAbs {
+	if m != nil {
+		return m.Stock
+	}
+	return nil
+}
+
+func (m *ArmyMoveArgs) GetArtifacts() []string {
+	if m != nil {
+		return m.Artifacts
+	}
+	return nil
+}
+
+func (m *ArmyMoveArgs) GetUnits() []string {
+	if m != nil {
+		return m.Units
+	}
+	return nil
+}
+
+type ArmyAssaultReq struct {
+	Id *ArmyId `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Unique ID of the map cell (which must have a city settled)
+	Target uint64 `protobuf:"varint,2,opt,name=target,proto3" json:"target,omitempty"`
+	// What to do upon victory
+	Args                 *ArmyAssaultArgs `protobuf:"bytes,3,opt,name=args,proto3" json:"args,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ArmyAssaultReq) Reset()         { *m = ArmyAssaultReq{} }
+func (m *ArmyAssaultReq) String() string { return proto.CompactTextString(m) }
+func (*ArmyAssaultReq) ProtoMessage()    {}
+func (*ArmyAssaultReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6eef30384a8831dd, []int{15}
+}
+
+func (m *ArmyAssaultReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ArmyAssaultReq.Unmarshal(m, b)
+}
+func (m *ArmyAssaultReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ArmyAssaultReq.Marshal(b, m, deterministic)
+}
+func (m *ArmyAssaultReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArmyAssaultReq.Merge(m, src)
+}
+func (m *ArmyAssaultReq) XXX_Size() int {
+	return xxx_messageInfo_ArmyAssaultReq.Size(m)
+}
+func (m *ArmyAssaultReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArmyAssaultReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ArmyAssaultReq proto.InternalMessageInfo
+
+func (m *ArmyAssaultReq) GetId() *ArmyId {
+	if m != nil {
+		return m.Id
+	}
+	return nil
+}
+
+func (m *ArmyAssaultReq) GetTarget() uint64 {
+	if m != nil {
+		return m.Target
+	}
+	return 0
+}
+
+func (m *ArmyAssaultReq) GetArgs() *ArmyAssaultArgs {
+	if m != nil {
+		return m.Args
+	}
+	return nil
+}
+
+type ArmyAssaultArgs struct {
+	// Massacre the peasants to force a production drop
+	Massacre bool `protobuf:"varint,1,opt,name=massacre,proto3" json:"massacre,omitempty"`
+	// Become overlord of the victim, in case of victory
+	Overlord bool `protobuf:"varint,2,opt,name=overlord,proto3" json:"overlord,omitempty"`
+	// Break a random building, in case of victory
+	Break                bool     `protobuf:"varint,3,opt,name=break,proto3" json:"break,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ArmyAssaultArgs) Reset()         { *m = ArmyAssaultArgs{} }
+func (m *ArmyAssaultArgs) String() string { return proto.CompactTextString(m) }
+func (*ArmyAssaultArgs) ProtoMessage()    {}
+func (*ArmyAssaultArgs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6eef30384a8831dd, []int{16}
+}
+
+func (m *ArmyAssaultArgs) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ArmyAssaultArgs.Unmarshal(m, b)
+}
+func (m *ArmyAssaultArgs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ArmyAssaultArgs.Marshal(b, m, deterministic)
+}
+func (m *ArmyAssaultArgs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArmyAssaultArgs.Merge(m, src)
+}
+func (m *ArmyAssaultArgs) XXX_Size() int {
+	return xxx_messageInfo_ArmyAssaultArgs.Size(m)
+}
+func (m *ArmyAssaultArgs) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArmyAssaultArgs.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ArmyAssaultArgs proto.InternalMessageInfo
+
+func (m *ArmyAssaultArgs) GetMassacre() bool {
+	if m != nil {
+		return m.Massacre
+	}
+	return false
+}
+
+func (m *ArmyAssaultArgs) GetOverlord() bool {
+	if m != nil {
+		return m.Overlord
+	}
+	return false
+}
+
+func (m *ArmyAssaultArgs) GetBreak() bool {
+	if m != nil {
+		return m.Break
+	}
+	return false
+}
+
+type ArmyTarget struct {
+	Id                   *ArmyId  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Target               uint64   `protobuf:"varint,2,opt,name=target,proto3" json:"target,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ArmyTarget) Reset()         { *m = ArmyTarget{} }
+func (m *ArmyTarget) String() string { return proto.CompactTextString(m) }
+func (*ArmyTarget) ProtoMessage()    {}
+func (*ArmyTarget) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6eef30384a8831dd, []int{17}
+}
+
+func (m *ArmyTarget) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ArmyTarget.Unmarshal(m, b)
+}
+func (m *ArmyTarget) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ArmyTarget.Marshal(b, m, deterministic)
+}
+func (m *ArmyTarget) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArmyTarget.Merge(m, src)
+}
+func (m *ArmyTarget) XXX_Size() int {
+	return xxx_messageInfo_ArmyTarget.Size(m)
+}
+func (m *ArmyTarget) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArmyTarget.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ArmyTarget proto.InternalMessageInfo
+
+func (m *ArmyTarget) GetId() *ArmyId {
+	if m != nil {
+		return m.Id
+	}
+	return nil
+}
+
+func (m *ArmyTarget) GetTarget() uint64 {
+	if m != nil {
+		return m.Target
+	}
+	return 0
+}
+
+type ArmyCommand struct {
+	Target uint64          `protobuf:"varint,1,opt,name=target,proto3" json:"target,omitempty"`
+	Type   ArmyCommandType `protobuf:"varint,2,opt,name=type,proto3,enum=hege.reg.ArmyCommandType" json:"type,omitempty"`
+	// Optional field that may be set in case of move
+	Move *ArmyMoveArgs `protobuf:"bytes,3,opt,name=move,proto3" json:"move,omitempty"`
+	// Optional field that may be set in case of attack
+	Attack               *ArmyAssaultArgs `protobuf:"bytes,4,opt,name=attack,proto3" json:"attack,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ArmyCommand) Reset()         { *m = ArmyCommand{} }
+func (m *ArmyCommand) String() string { return proto.CompactTextString(m) }
+func (*ArmyCommand) ProtoMessage()    {}
+func (*ArmyCommand) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6eef30384a8831dd, []int{18}
+}
+
+func (m *ArmyCommand) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ArmyCommand.Unmarshal(m, b)
+}
+func (m *ArmyCommand) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ArmyCommand.Marshal(b, m, deterministic)
+}
+func (m *ArmyCommand) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArmyCommand.Merge(m, src)
+}
+func (m *ArmyCommand) XXX_Size() int {
+	return xxx_messageInfo_ArmyCommand.Size(m)
+}
+func (m *ArmyCommand) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArmyCommand.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ArmyCommand proto.InternalMessageInfo
+
+func (m *ArmyCommand) GetTarget() uint64 {
+	if m != nil {
+		return m.Target
+	}
+	return 0
+}
+
+func (m *ArmyCommand) GetType() ArmyCommandType {
+	if m != nil {
+		return m.Type
+	}
+	return ArmyCommandType_Unknown
+}
+
+func (m *ArmyCommand) GetMove() *ArmyMoveArgs {
+	if m != nil {
+		return m.Move
+	}
+	return nil
+}
+
+func (m *ArmyCommand) GetAttack() *ArmyAssaultArgs {
+	if m != nil {
+		return m.Attack
+	}
+	return nil
+}
+
+// Identifies a City and Character who is
+type CityId struct {
+	Region               string   `protobuf:"bytes,1,opt,name=region,proto3" json:"region,omitempty"`
+	Character            string   `protobuf:"bytes,2,opt,name=character,proto3" json:"character,omitempty"`
+	City                 uint64   `protobuf:"varint,3,opt,name=city,proto3" json:"city,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CityId) Reset()         { *m = CityId{} }
+func (m *CityId) String() string { return proto.CompactTextString(m) }
+func (*CityId) ProtoMessage()    {}
+func (*CityId) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6eef30384a8831dd, []int{19}
+}
+
+func (m *CityId) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CityId.Unmarshal(m, b)
+}
+func (m *CityId) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CityId.Marshal(b, m, deterministic)
+}
+func (m *CityId) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CityId.Merge(m, src)
+}
+func (m *CityId) XXX_Size() int {
+	return xxx_messageInfo_CityId.Size(m)
+}
+func (m *CityId) XXX_DiscardUnknown() {
+	xxx_messageInfo_CityId.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CityId proto.InternalMessageInfo
+
+func (m *CityId) GetRegion() string {
+	if m != nil {
+		return m.Region
+	}
+	return ""
+}
+
+func (m *CityId) GetCharacter() string {
+	if m != nil {
+		return m.Character
+	}
+	return ""
+}
+
+func (m *CityId) GetCity() uint64 {
+	if m != nil {
+		return m.City
+	}
+	return 0
+}
+
+type ResourcesAbs struct {
+	R0                   uint64   `protobuf:"varint,1,opt,name=r0,proto3" json:"r0,omitempty"`
+	R1                   uint64   `protobuf:"varint,2,opt,name=r1,proto3" json:"r1,omitempty"`
+	R2                   uint64   `protobuf:"varint,3,opt,name=r2,proto3" json:"r2,omitempty"`
+	R3                   uint64   `protobuf:"varint,4,opt,name=r3,proto3" json:"r3,omitempty"`
+	R4                   uint64   `protobuf:"varint,5,opt,name=r4,proto3" json:"r4,omitempty"`
+	R5                   uint64   `protobuf:"varint,6,opt,name=r5,proto3" json:"r5,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ResourcesAbs) Reset()         { *m = ResourcesAbs{} }
+func (m *ResourcesAbs) String() string { return proto.CompactTextString(m) }
+func (*ResourcesAbs) ProtoMessage()    {}
+func (*ResourcesAbs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6eef30384a8831dd, []int{20}
+}
+
+func (m *ResourcesAbs) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ResourcesAbs.Unmarshal(m, b)
+}
+func (m *ResourcesAbs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ResourcesAbs.Marshal(b, m, deterministic)
+}
+func (m *ResourcesAbs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResourcesAbs.Merge(m, src)
+}
+func (m *ResourcesAbs) XXX_Size() int {
+	return xxx_messageInfo_ResourcesAbs.Size(m)
+}
+func (m *ResourcesAbs) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResourcesAbs.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResourcesAbs proto.InternalMessageInfo
+
+func (m *ResourcesAbs) GetR0() uint64 {
+	if m != nil {
+		return m.R0
+	}
+	return 0
+}
+
+func (m *ResourcesAbs) GetR1() uint64 {
+	if m != nil {
+		return m.R1
+	}
+	return 0
+}
+
+func (m *ResourcesAbs) GetR2() uint64 {
+	if m != nil {
+		return m.R2
+	}
+	return 0
+}
+
+func (m *ResourcesAbs) GetR3() uint64 {
+	if m != nil {
+		return m.R3
+	}
+	return 0
+}
+
+func (m *ResourcesAbs) GetR4() uint64 {
+	if m != nil {
+		return m.R4
+	}
+	return 0
+}
+
+func (m *ResourcesAbs) GetR5() uint64 {
+	if m != nil {
+		return m.R5
+	}
+	return 0
+}
+
+type ResourcesPlus struct {
+	R0                   int64    `protobuf:"varint,1,opt,name=r0,proto3" json:"r0,omitempty"`
+	R1                   int64    `protobuf:"varint,2,opt,name=r1,proto3" json:"r1,omitempty"`
+	R2                   int64    `protobuf:"varint,3,opt,name=r2,proto3" json:"r2,omitempty"`
+	R3                   int64    `protobuf:"varint,4,opt,name=r3,proto3" json:"r3,omitempty"`
+	R4                   int64    `protobuf:"varint,5,opt,name=r4,proto3" json:"r4,omitempty"`
+	R5                   int64    `protobuf:"varint,6,opt,name=r5,proto3" json:"r5,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ResourcesPlus) Reset()         { *m = ResourcesPlus{} }
+func (m *ResourcesPlus) String() string { return proto.CompactTextString(m) }
+func (*ResourcesPlus) ProtoMessage()    {}
+func (*ResourcesPlus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6eef30384a8831dd, []int{21}
+}
+
+func (m *ResourcesPlus) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ResourcesPlus.Unmarshal(m, b)
+}
+func (m *ResourcesPlus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ResourcesPlus.Marshal(b, m, deterministic)
+}
+func (m *ResourcesPlus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResourcesPlus.Merge(m, src)
+}
+func (m *ResourcesPlus) XXX_Size() int {
+	return xxx_messageInfo_ResourcesPlus.Size(m)
+}
+func (m *ResourcesPlus) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResourcesPlus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResourcesPlus proto.InternalMessageInfo
+
+func (m *ResourcesPlus) GetR0() int64 {
+	if m != nil {
+		return m.R0
+	}
+	return 0
+}
+
+func (m *ResourcesPlus) GetR1() int64 {
+	if m != nil {
+		return m.R1
+	}
+	return 0
+}
+
+func (m *ResourcesPlus) GetR2() int64 {
+	if m != nil {
+		return m.R2
+	}
+	return 0
+}
+
+func (m *ResourcesPlus) GetR3() int64 {
+	if m != nil {
+		return m.R3
+	}
+	return 0
+}
+
+func (m *ResourcesPlus) GetR4() int64 {
+	if m != nil {
+		return m.R4
+	}
+	return 0
+}
+
+func (m *ResourcesPlus) GetR5() int64 {
+	if m != nil {
+		return m.R5
+	}
+	return 0
+}
+
+type ResourcesMult struct {
+	R0                   float64  `protobuf:"fixed64,1,opt,name=r0,proto3" json:"r0,omitempty"`
+	R1                   float64  `protobuf:"fixed64,2,opt,name=r1,proto3" json:"r1,omitempty"`
+	R2                   float64  `protobuf:"fixed64,3,opt,name=r2,proto3" json:"r2,omitempty"`
+	R3                   float64  `protobuf:"fixed64,4,opt,name=r3,proto3" json:"r3,omitempty"`
+	R4                   float64  `protobuf:"fixed64,5,opt,name=r4,proto3" json:"r4,omitempty"`
+	R5                   float64  `protobuf:"fixed64,6,opt,name=r5,proto3" json:"r5,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ResourcesMult) Reset()         { *m = ResourcesMult{} }
+func (m *ResourcesMult) String() string { return proto.CompactTextString(m) }
+func (*ResourcesMult) ProtoMessage()    {}
+func (*ResourcesMult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6eef30384a8831dd, []int{22}
+}
+
+func (m *ResourcesMult) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ResourcesMult.Unmarshal(m, b)
+}
+func (m *ResourcesMult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ResourcesMult.Marshal(b, m, deterministic)
+}
+func (m *ResourcesMult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResourcesMult.Merge(m, src)
+}
+func (m *ResourcesMult) XXX_Size() int {
+	return xxx_messageInfo_ResourcesMult.Size(m)
+}
+func (m *ResourcesMult) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResourcesMult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResourcesMult proto.InternalMessageInfo
+
+func (m *ResourcesMult) GetR0() float64 {
+	if m != nil {
+		return m.R0
+	}
+	return 0
+}
+
+func (m *ResourcesMult) GetR1() float64 {
+	if m != nil {
+		return m.R1
+	}
+	return 0
+}
+
+func (m *ResourcesMult) GetR2() float64 {
+	if m != nil {
+		return m.R2
+	}
+	return 0
+}
+
+func (m *ResourcesMult) GetR3() float64 {
+	if m != nil {
+		return m.R3
+	}
+	return 0
+}
+
+func (m *ResourcesMult) GetR4() float64 {
+	if m != nil {
+		return m.R4
+	}
+	return 0
+}
+
+func (m *ResourcesMult) GetR5() float64 {
+	if m != nil {
+		return m.R5
+	}
+	return 0
+}
+
+type ResourcesMod struct {
+	Plus                 *ResourcesPlus `protobuf:"bytes,1,opt,name=plus,proto3" json:"plus,omitempty"`
+	Mult                 *ResourcesMult `protobuf:"bytes,2,opt,name=mult,proto3" json:"mult,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *ResourcesMod) Reset()         { *m = ResourcesMod{} }
+func (m *ResourcesMod) String() string { return proto.CompactTextString(m) }
+func (*ResourcesMod) ProtoMessage()    {}
+func (*ResourcesMod) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6eef30384a8831dd, []int{23}
+}
+
+func (m *ResourcesMod) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ResourcesMod.Unmarshal(m, b)
+}
+func (m *ResourcesMod) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ResourcesMod.Marshal(b, m, deterministic)
+}
+func (m *ResourcesMod) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResourcesMod.Merge(m, src)
+}
+func (m *ResourcesMod) XXX_Size() int {
+	return xxx_messageInfo_ResourcesMod.Size(m)
+}
+func (m *ResourcesMod) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResourcesMod.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResourcesMod proto.InternalMessageInfo
+
+func (m *ResourcesMod) GetPlus() *ResourcesPlus {
+	if m != nil {
+		return m.Plus
+	}
+	return nil
+}
+
+func (m *ResourcesMod) GetMult() *ResourcesMult {
+	if m != nil {
+		return m.Mult
+	}
+	return nil
+}
+
+type UnitTypeView struct {
+	Id           uint64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name         string  `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Ticks        uint32  `protobuf:"varint,3,opt,name=ticks,proto3" json:"ticks,omitempty"`
+	Health       uint32  `protobuf:"varint,4,opt,name=health,proto3" json:"health,omitempty"`
+	HealthFactor float64 `protobuf:"fixed64,5,opt,name=healthFactor,proto3" json:"healthFactor,omitempty"`
+	Attack       uint32  `protobuf:"varint,6,opt,name=attack,proto3" json:"attack,omitempty"`
+	Defense      uint32  `protobuf:"varint,7,opt,name=defense,proto3" json:"defense,omitempty"`
+	Armor        uint32  `protobuf:"varint,8,opt,name=armor,proto3" json:"armor,omitempty"`
+	// Informative only, the armies move one cell per round
+	Speed uint32 `protobuf:"varint,9,opt,name=speed,proto3" json:"speed,omitempty"`
+	// Informative only, the transports do not enforce it
+	Carry                uint64           `protobuf:"varint,10,opt,name=carry,proto3" json:"carry,omitempty"`
+	Bonus                []*UnitTypeBonus `protobuf:"bytes,11,rep,name=bonus,proto3" json:"bonus,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *UnitTypeView) Reset()         { *m = UnitTypeView{} }
+func (m *UnitTypeView) String() string { return proto.CompactTextString(m) }
+func (*UnitTypeView) ProtoMessage()    {}
+func (*UnitTypeView) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6eef30384a8831dd, []int{24}
+}
+
+func (m *UnitTypeView) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UnitTypeView.Unmarshal(m, b)
+}
+func (m *UnitTypeView) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UnitTypeView.Marshal(b, m, deterministic)
+}
+func (m *UnitTypeView) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnitTypeView.Merge(m, src)
+}
+func (m *UnitTypeView) XXX_Size() int {
+	return xxx_messageInfo_UnitTypeView.Size(m)
+}
+func (m *UnitTypeView) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnitTypeView.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnitTypeView proto.InternalMessageInfo
+
+func (m *UnitTypeView) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *UnitTypeView) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *UnitTypeView) GetTicks() uint32 {
+	if m != nil {
+		return m.Ticks
+	}
+	return 0
+}
+
+func (m *UnitTypeView) GetHealth() uint32 {
+	if m != nil {
+		return m.Health
+	}
+	return 0
+}
+
+func (m *UnitTypeView) GetHealthFactor() float64 {
+	if m != nil {
+		return m.HealthFactor
+	}
+	return 0
+}
+
+func (m *UnitTypeView) GetAttack() uint32 {
+	if m != nil {
+		return m.Attack
+	}
+	return 0
+}
+
+func (m *UnitTypeView) GetDefense() uint32 {
+	if m != nil {
+		return m.Defense
+	}
+	return 0
+}
+
+func (m *UnitTypeView) GetArmor() uint32 {
+	if m != nil {
+		return m.Armor
+	}
+	return 0
+}
+
+func (m *UnitTypeView) GetSpeed() uint32 {
+	if m != nil {
+		return m.Speed
+	}
+	return 0
+}
+
+func (m *UnitTypeView) GetCarry() uint64 {
+	if m != nil {
+		return m.Carry
+	}
+	return 0
+}
+
+func (m *UnitTypeView) GetBonus() []*UnitTypeBonus {
+	if m != nil {
+		return m.Bonus
+	}
+	return nil
+}
+
+type UnitTypeBonus struct {
+	IdType               uint64   `protobuf:"varint,1,opt,name=idType,proto3" json:"idType,omitempty"`
+	Mult                 float64  `protobuf:"fixed64,2,opt,name=mult,proto3" json:"mult,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UnitTypeBonus) Reset()         { *m = UnitTypeBonus{} }
+func (m *UnitTypeBonus) String() string { return proto.CompactTextString(m) }
+func (*UnitTypeBonus) ProtoMessage()    {}
+func (*UnitTypeBonus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6eef30384a8831dd, []int{25}
+}
+
+func (m *UnitTypeBonus) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UnitTypeBonus.Unmarshal(m, b)
+}
+func (m *UnitTypeBonus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UnitTypeBonus.Marshal(b, m, deterministic)
+}
+func (m *UnitTypeBonus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnitTypeBonus.Merge(m, src)
+}
+func (m *UnitTypeBonus) XXX_Size() int {
+	return xxx_messageInfo_UnitTypeBonus.Size(m)
+}
+func (m *UnitTypeBonus) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnitTypeBonus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnitTypeBonus proto.InternalMessageInfo
+
+func (m *UnitTypeBonus) GetIdType() uint64 {
+	if m != nil {
+		return m.IdType
+	}
+	return 0
+}
+
+func (m *UnitTypeBonus) GetMult() float64 {
+	if m != nil {
+		return m.Mult
+	}
+	return 0
+}
+
+type BuildingTypeView struct {
+	Id                   uint64   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Ticks                uint32   `protobuf:"varint,3,opt,name=ticks,proto3" json:"ticks,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BuildingTypeView) Reset()         { *m = BuildingTypeView{} }
+func (m *BuildingTypeView) String() string { return proto.CompactTextString(m) }
+func (*BuildingTypeView) ProtoMessage()    {}
+func (*BuildingTypeView) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6eef30384a8831dd, []int{26}
+}
+
+func (m *BuildingTypeView) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BuildingTypeView.Unmarshal(m, b)
+}
+func (m *BuildingTypeView) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BuildingTypeView.Marshal(b, m, deterministic)
+}
+func (m *BuildingTypeView) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BuildingTypeView.Merge(m, src)
+}
+func (m *BuildingTypeView) XXX_Size() int {
+	return xxx_messageInfo_BuildingTypeView.Size(m)
+}
+func (m *BuildingTypeView) XXX_DiscardUnknown() {
+	xxx_messageInfo_BuildingTypeView.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BuildingTypeView proto.InternalMessageInfo
+
+func (m *BuildingTypeView) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *BuildingTypeView) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *BuildingTypeView) GetTicks() uint32 {
+	if m != nil {
+		return m.Ticks
+	}
+	return 0
+}
+
+type KnowledgeTypeView struct {
+	Id                   uint64   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Ticks                uint32   `protobuf:"varint,3,opt,name=ticks,proto3" json:"ticks,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *KnowledgeTypeView) Reset()         { *m = KnowledgeTypeView{} }
+func (m *KnowledgeTypeView) String() string { return proto.CompactTextString(m) }
+func (*KnowledgeTypeView) ProtoMessage()    {}
+func (*KnowledgeTypeView) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6eef30384a8831dd, []int{27}
+}
+
+func (m *KnowledgeTypeView) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_KnowledgeTypeView.Unmarshal(m, b)
+}
+func (m *KnowledgeTypeView) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_KnowledgeTypeView.Marshal(b, m, deterministic)
+}
+func (m *KnowledgeTypeView) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KnowledgeTypeView.Merge(m, src)
+}
+func (m *KnowledgeTypeView) XXX_Size() int {
+	return xxx_messageInfo_KnowledgeTypeView.Size(m)
+}
+func (m *KnowledgeTypeView) XXX_DiscardUnknown() {
+	xxx_messageInfo_KnowledgeTypeView.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_KnowledgeTypeView proto.InternalMessageInfo
+
+func (m *KnowledgeTypeView) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *KnowledgeTypeView) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *KnowledgeTypeView) GetTicks() uint32 {
+	if m != nil {
+		return m.Ticks
+	}
+	return 0
+}
+
+type ArtifactTypeView struct {
+	Id                   uint64        `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name                 string        `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Visible              bool          `protobuf:"varint,3,opt,name=visible,proto3" json:"visible,omitempty"`
+	PopBonus             int64         `protobuf:"varint,4,opt,name=popBonus,proto3" json:"popBonus,omitempty"`
+	Stock                *ResourcesMod `protobuf:"bytes,5,opt,name=stock,proto3" json:"stock,omitempty"`
+	Prod                 *ResourcesMod `protobuf:"bytes,6,opt,name=prod,proto3" json:"prod,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *ArtifactTypeView) Reset()         { *m = ArtifactTypeView{} }
+func (m *ArtifactTypeView) String() string { return proto.CompactTextString(m) }
+func (*ArtifactTypeView) ProtoMessage()    {}
+func (*ArtifactTypeView) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6eef30384a8831dd, []int{28}
+}
+
+func (m *ArtifactTypeView) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ArtifactTypeView.Unmarshal(m, b)
+}
+func (m *ArtifactTypeView) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ArtifactTypeView.Marshal(b, m, deterministic)
+}
+func (m *ArtifactTypeView) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArtifactTypeView.Merge(m, src)
+}
+func (m *ArtifactTypeView) XXX_Size() int {
+	return xxx_messageInfo_ArtifactTypeView.Size(m)
+}
+func (m *ArtifactTypeView) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArtifactTypeView.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ArtifactTypeView proto.InternalMessageInfo
+
+func (m *ArtifactTypeView) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *ArtifactTypeView) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ArtifactTypeView) GetVisible() bool {
+	if m != nil {
+		return m.Visible
+	}
+	return false
+}
+
+func (m *ArtifactTypeView) GetPopBonus() int64 {
+	if m != nil {
+		return m.PopBonus
+	}
+	return 0
+}
+
+func (m *ArtifactTypeView) GetStock() *ResourcesMod {
+	if m != nil {
+		return m.Stock
+	}
+	return nil
+}
+
+func (m *ArtifactTypeView) GetProd() *ResourcesMod {
+	if m != nil {
+		return m.Prod
+	}
+	return nil
+}
+
+type UnitView struct {
+	// Lazily populated
+	Type   *UnitTypeView `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Id     string        `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	IdType uint64        `protobuf:"varint,3,opt,name=idType,proto3" json:"idType,omitempty"`
+	Ticks  uint32        `protobuf:"varint,4,opt,name=ticks,proto3" json:"ticks,omitempty"`
+	Health uint32        `protobuf:"varint,5,opt,name=health,proto3" json:"health,omitempty"`
+	Name   string        `protobuf:"bytes,6,opt,name=name,proto3" json:"name,omitempty"`
+	// The health of the unit when it is healthy, as configured in its type
+	HealthMax            uint32   `protobuf:"varint,7,opt,name=healthMax,proto3" json:"healthMax,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UnitView) Reset()         { *m = UnitView{} }
+func (m *UnitView) String() string { return proto.CompactTextString(m) }
+func (*UnitView) ProtoMessage()    {}
+func (*UnitView) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6eef30384a8831dd, []int{29}
+}
+
+func (m *UnitView) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UnitView.Unmarshal(m, b)
+}
+func (m *UnitView) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UnitView.Marshal(b, m, deterministic)
+}
+func (m *UnitView) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnitView.Merge(m, src)
+}
+func (m *UnitView) XXX_Size() int {
+	return xxx_messageInfo_UnitView.Size(m)
+}
+func (m *UnitView) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnitView.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnitView proto.InternalMessageInfo
+
+func (m *UnitView) GetType() *UnitTypeView {
+	if m != nil {
+		return m.Type
+	}
+	return nil
+}
+
+func (m *UnitView) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *UnitView) GetIdType() uint64 {
+	if m != nil {
+		return m.IdType
+	}
+	return 0
+}
+
+func (m *UnitView) GetTicks() uint32 {
+	if m != nil {
+		return m.Ticks
+	}
+	return 0
+}
+
+func (m *UnitView) GetHealth() uint32 {
+	if m != nil {
+		return m.Health
+	}
+	return 0
+}
+
+func (m *UnitView) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *UnitView) GetHealthMax() uint32 {
+	if m != nil {
+		return m.HealthMax
+	}
+	return 0
+}
+
+type BuildingView struct {
+	Type                 *BuildingTypeView `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Id                   string            `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	IdType               uint64            `protobuf:"varint,3,opt,name=idType,proto3" json:"idType,omitempty"`
+	Ticks                uint32            `protobuf:"varint,4,opt,name=ticks,proto3" json:"ticks,omitempty"`
+	Name                 string            `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *BuildingView) Reset()         { *m = BuildingView{} }
+func (m *BuildingView) String() string { return proto.CompactTextString(m) }
+func (*BuildingView) ProtoMessage()    {}
+func (*BuildingView) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6eef30384a8831dd, []int{30}
+}
+
+func (m *BuildingView) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BuildingView.Unmarshal(m, b)
+}
+func (m *BuildingView) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BuildingView.Marshal(b, m, deterministic)
+}
+func (m *BuildingView) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BuildingView.Merge(m, src)
+}
+func (m *BuildingView) XXX_Size() int {
+	return xxx_messageInfo_BuildingView.Size(m)
+}
+func (m *BuildingView) XXX_DiscardUnknown() {
+	xxx_messageInfo_BuildingView.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BuildingView proto.InternalMessageInfo
+
+func (m *BuildingView) GetType() *BuildingTypeView {
+	if m != nil {
+		return m.Type
+	}
+	return nil
+}
+
+func (m *BuildingView) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *BuildingView) GetIdType() uint64 {
+	if m != nil {
+		return m.IdType
+	}
+	return 0
+}
+
+func (m *BuildingView) GetTicks() uint32 {
+	if m != nil {
+		return m.Ticks
+	}
+	return 0
+}
+
+func (m *BuildingView) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+type KnowledgeView struct {
+	Type                 *KnowledgeTypeView `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Id                   string             `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	IdType               uint64             `protobuf:"varint,3,opt,name=idType,proto3" json:"idType,omitempty"`
+	Ticks                uint32             `protobuf:"varint,4,opt,name=ticks,proto3" json:"ticks,omitempty"`
+	Name                 string             `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *KnowledgeView) Reset()         { *m = KnowledgeView{} }
+func (m *KnowledgeView) String() string { return proto.CompactTextString(m) }
+func (*KnowledgeView) ProtoMessage()    {}
+func (*KnowledgeView) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6eef30384a8831dd, []int{31}
+}
+
+func (m *KnowledgeView) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_KnowledgeView.Unmarshal(m, b)
+}
+func (m *KnowledgeView) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_KnowledgeView.Marshal(b, m, deterministic)
+}
+func (m *KnowledgeView) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KnowledgeView.Merge(m, src)
+}
+func (m *KnowledgeView) XXX_Size() int {
+	return xxx_messageInfo_KnowledgeView.Size(m)
+}
+func (m *KnowledgeView) XXX_DiscardUnknown() {
+	xxx_messageInfo_KnowledgeView.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_KnowledgeView proto.InternalMessageInfo
+
+func (m *KnowledgeView) GetType() *KnowledgeTypeView {
+	if m != nil {
+		return m.Type
+	}
+	return nil
+}
+
+func (m *KnowledgeView) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *KnowledgeView) GetIdType() uint64 {
+	if m != nil {
+		return m.IdType
+	}
+	return 0
+}
+
+func (m *KnowledgeView) GetTicks() uint32 {
+	if m != nil {
+		return m.Ticks
+	}
+	return 0
+}
+
+func (m *KnowledgeView) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+type StockView struct {
+	Base                 *ResourcesAbs `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Knowledge            *ResourcesMod `protobuf:"bytes,2,opt,name=knowledge,proto3" json:"knowledge,omitempty"`
+	Buildings            *ResourcesMod `protobuf:"bytes,3,opt,name=buildings,proto3" json:"buildings,omitempty"`
+	Troops               *ResourcesMod `protobuf:"bytes,4,opt,name=troops,proto3" json:"troops,omitempty"`
+	Actual               *ResourcesAbs `protobuf:"bytes,5,opt,name=actual,proto3" json:"actual,omitempty"`
+	Usage                *ResourcesAbs `protobuf:"bytes,6,opt,name=usage,proto3" json:"usage,omitempty"`
+	Artifacts            *ResourcesMod `protobuf:"bytes,7,opt,name=artifacts,proto3" json:"artifacts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *StockView) Reset()         { *m = StockView{} }
+func (m *StockView) String() string { return proto.CompactTextString(m) }
+func (*StockView) ProtoMessage()    {}
+func (*StockView) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6eef30384a8831dd, []int{32}
+}
+
+func (m *StockView) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StockView.Unmarshal(m, b)
+}
+func (m *StockView) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StockView.Marshal(b, m, deterministic)
+}
+func (m *StockView) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StockView.Merge(m, src)
+}
+func (m *StockView) XXX_Size() int {
+	return xxx_messageInfo_StockView.Size(m)
+}
+func (m *StockView) XXX_DiscardUnknown() {
+	xxx_messageInfo_StockView.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StockView proto.InternalMessageInfo
+
+func (m *StockView) GetBase() *ResourcesAbs {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *StockView) GetKnowledge() *ResourcesMod {
+	if m != nil {
+		return m.Knowledge
+	}
+	return nil
+}
+
+func (m *StockView) GetBuildings() *ResourcesMod {
+	if m != nil {
+		return m.Buildings
+	}
+	return nil
+}
+
+func (m *StockView) GetTroops() *ResourcesMod {
+	if m != nil {
+		return m.Troops
+	}
+	return nil
+}
+
+func (m *StockView) GetActual() *ResourcesAbs {
+	if m != nil {
+		return m.Actual
+	}
+	return nil
+}
+
+func (m *StockView) GetUsage() *ResourcesAbs {
+	if m != nil {
+		return m.Usage
+	}
+	return nil
+}
+
+func (m *StockView) GetArtifacts() *ResourcesMod {
+	if m != nil {
+		return m.Artifacts
+	}
+	return nil
+}
+
+type ProductionView struct {
+	Base      *ResourcesAbs `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Knowledge *ResourcesMod `protobuf:"bytes,2,opt,name=knowledge,proto3" json:"knowledge,omitempty"`
+	Buildings *ResourcesMod `protobuf:"bytes,3,opt,name=buildings,proto3" json:"buildings,omitempty"`
+	Troops    *ResourcesMod `protobuf:"bytes,4,opt,name=troops,proto3" json:"troops,omitempty"`
+	Actual    *ResourcesAbs `protobuf:"bytes,5,opt,name=actual,proto3" json:"actual,omitempty"`
+	Artifacts *ResourcesMod `protobuf:"bytes,6,opt,name=artifacts,proto3" json:"artifacts,omitempty"`
+	// Part of the upkeep of the troops that the production cannot cover,
+	// and that is taken from the stock.
+	Shortage             *ResourcesAbs `protobuf:"bytes,7,opt,name=shortage,proto3" json:"shortage,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *ProductionView) Reset()         { *m = ProductionView{} }
+func (m *ProductionView) String() string { return proto.CompactTextString(m) }
+func (*ProductionView) ProtoMessage()    {}
+func (*ProductionView) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6eef30384a8831dd, []int{33}
+}
+
+func (m *ProductionView) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ProductionView.Unmarshal(m, b)
+}
+func (m *ProductionView) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ProductionView.Marshal(b, m, deterministic)
+}
+func (m *ProductionView) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProductionView.Merge(m, src)
+}
+func (m *ProductionView) XXX_Size() int {
+	return xxx_messageInfo_ProductionView.Size(m)
+}
+func (m *ProductionView) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProductionView.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProductionView proto.InternalMessageInfo
+
+func (m *ProductionView) GetBase() *ResourcesAbs {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *ProductionView) GetKnowledge() *ResourcesMod {
+	if m != nil {
+		return m.Knowledge
+	}
+	return nil
+}
+
+func (m *ProductionView) GetBuildings() *ResourcesMod {
+	if m != nil {
+		return m.Buildings
+	}
+	return nil
+}
+
+func (m *ProductionView) GetTroops() *ResourcesMod {
+	if m != nil {
+		return m.Troops
+	}
+	return nil
+}
+
+func (m *ProductionView) GetActual() *ResourcesAbs {
+	if m != nil {
+		return m.Actual
+	}
+	return nil
+}
+
+func (m *ProductionView) GetArtifacts() *ResourcesMod {
+	if m != nil {
+		return m.Artifacts
+	}
+	return nil
+}
+
+func (m *ProductionView) GetShortage() *ResourcesAbs {
+	if m != nil {
+		return m.Shortage
+	}
+	return nil
+}
+
+type CityEvolution struct {
+	KFrontier            []*KnowledgeTypeView `protobuf:"bytes,1,rep,name=kFrontier,proto3" json:"kFrontier,omitempty"`
+	BFrontier            []*BuildingTypeView  `protobuf:"bytes,2,rep,name=bFrontier,proto3" json:"bFrontier,omitempty"`
+	UFrontier            []*UnitTypeView      `protobuf:"bytes,3,rep,name=uFrontier,proto3" json:"uFrontier,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *CityEvolution) Reset()         { *m = CityEvolution{} }
+func (m *CityEvolution) String() string { return proto.CompactTextString(m) }
+func (*CityEvolution) ProtoMessage()    {}
+func (*CityEvolution) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6eef30384a8831dd, []int{34}
+}
+
+func (m *CityEvolution) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CityEvolution.Unmarshal(m, b)
+}
+func (m *CityEvolution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CityEvolution.Marshal(b, m, deterministic)
+}
+func (m *CityEvolution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CityEvolution.Merge(m, src)
+}
+func (m *CityEvolution) XXX_Size() int {
+	return xxx_messageInfo_CityEvolution.Size(m)
+}
+func (m *CityEvolution) XXX_DiscardUnknown() {
+	xxx_messageInfo_CityEvolution.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CityEvolution proto.InternalMessageInfo
+
+func (m *CityEvolution) GetKFrontier() []*KnowledgeTypeView {
+	if m != nil {
+		return m.KFrontier
+	}
+	return nil
+}
+
+func (m *CityEvolution) GetBFrontier() []*BuildingTypeView {
+	if m != nil {
+		return m.BFrontier
+	}
+	return nil
+}
+
+func (m *CityEvolution) GetUFrontier() []*UnitTypeView {
+	if m != nil {
+		return m.UFrontier
+	}
+	return nil
+}
+
+type CityAssets struct {
+	Units                []*UnitView      `protobuf:"bytes,1,rep,name=units,proto3" json:"units,omitempty"`
+	Buildings            []*BuildingView  `protobuf:"bytes,2,rep,name=buildings,proto3" json:"buildings,omitempty"`
+	Knowledges           []*KnowledgeView `protobuf:"bytes,3,rep,name=knowledges,proto3" json:"knowledges,omitempty"`
+	Armies               []*ArmyView      `protobuf:"bytes,4,rep,name=armies,proto3" json:"armies,omitempty"`
+	Artifacts            []*Artifact      `protobuf:"bytes,5,rep,name=artifacts,proto3" json:"artifacts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *CityAssets) Reset()         { *m = CityAssets{} }
+func (m *CityAssets) String() string { return proto.CompactTextString(m) }
+func (*CityAssets) ProtoMessage()    {}
+func (*CityAssets) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6eef30384a8831dd, []int{35}
+}
+
+func (m *CityAssets) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CityAssets.Unmarshal(m, b)
+}
+func (m *CityAssets) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CityAssets.Marshal(b, m, deterministic)
+}
+func (m *CityAssets) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CityAssets.Merge(m, src)
+}
+func (m *CityAssets) XXX_Size() int {
+	return xxx_messageInfo_CityAssets.Size(m)
+}
+func (m *CityAssets) XXX_DiscardUnknown() {
+	xxx_messageInfo_CityAssets.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CityAssets proto.InternalMessageInfo
+
+func (m *CityAssets) GetUnits() []*UnitView {
+	if m != nil {
+		return m.Units
+	}
+	return nil
+}
+
+func (m *CityAssets) GetBuildings() []*BuildingView {
+	if m != nil {
+		return m.Buildings
+	}
+	return nil
+}
+
+func (m *CityAssets) GetKnowledges() []*KnowledgeView {
+	if m != nil {
+		return m.Knowledges
+	}
+	return nil
+}
+
+func (m *CityAssets) GetArmies() []*ArmyView {
+	if m != nil {
+		return m.Armies
+	}
+	return nil
+}
+
+func (m *CityAssets) GetArtifacts() []*Artifact {
+	if m != nil {
+		return m.Artifacts
+	}
+	return nil
+}
+
+type CityPolitics struct {
+	Overlord             uint64   `protobuf:"varint,1,opt,name=overlord,proto3" json:"overlord,omitempty"`
+	Lieges               []uint64 `protobuf:"varint,2,rep,packed,name=lieges,proto3" json:"lieges,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CityPolitics) Reset()         { *m = CityPolitics{} }
+func (m *CityPolitics) String() string { return proto.CompactTextString(m) }
+func (*CityPolitics) ProtoMessage()    {}
+func (*CityPolitics) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6eef30384a8831dd, []int{36}
+}
+
+func (m *CityPolitics) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CityPolitics.Unmarshal(m, b)
+}
+func (m *CityPolitics) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CityPolitics.Marshal(b, m, deterministic)
+}
+func (m *CityPolitics) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CityPolitics.Merge(m, src)
+}
+func (m *CityPolitics) XXX_Size() int {
+	return xxx_messageInfo_CityPolitics.Size(m)
+}
+func (m *CityPolitics) XXX_DiscardUnknown() {
+	xxx_messageInfo_CityPolitics.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CityPolitics proto.InternalMessageInfo
+
+func (m *CityPolitics) GetOverlord() uint64 {
+	if m != nil {
+		return m.Overlord
+	}
+	return 0
+}
+
+func (m *CityPolitics) GetLieges() []uint64 {
+	if m != nil {
+		return m.Lieges
+	}
+	return nil
+}
+
+type PublicCity struct {
+	Id        uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name      string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Alignment int32  `protobuf:"varint,4,opt,name=alignment,proto3" json:"alignment,omitempty"`
+	Chaos     int32  `protobuf:"varint,5,opt,name=chaos,proto3" json:"chaos,omitempty"`
+	Politics  uint32 `protobuf:"varint,6,opt,name=politics,proto3" json:"politics,omitempty"`
+	Cult      uint32 `protobuf:"varint,7,opt,name=cult,proto3" json:"cult,omitempty"`
+	Ethny     uint32 `protobuf:"varint,8,opt,name=ethny,proto3" json:"ethny,omitempty"`
+	Score     int64  `protobuf:"varint,9,opt,name=score,proto3" json:"score,omitempty"`
+	// Only the visible Artifacts
+	Artifacts            []*Artifact `protobuf:"bytes,10,rep,name=artifacts,proto3" json:"artifacts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *PublicCity) Reset()         { *m = PublicCity{} }
+func (m *PublicCity) String() string { return proto.CompactTextString(m) }
+func (*PublicCity) ProtoMessage()    {}
+func (*PublicCity) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6eef30384a8831dd, []int{37}
+}
+
+func (m *PublicCity) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PublicCity.Unmarshal(m, b)
+}
+func (m *PublicCity) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PublicCity.Marshal(b, m, deterministic)
+}
+func (m *PublicCity) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PublicCity.Merge(m, src)
+}
+func (m *PublicCity) XXX_Size() int {
+	return xxx_messageInfo_PublicCity.Size(m)
+}
+func (m *PublicCity) XXX_DiscardUnknown() {
+	xxx_messageInfo_PublicCity.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PublicCity proto.InternalMessageInfo
+
+func (m *PublicCity) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *PublicCity) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *PublicCity) GetAlignment() int32 {
+	if m != nil {
+		return m.Alignment
+	}
+	return 0
+}
+
+func (m *PublicCity) GetChaos() int32 {
+	if m != nil {
+		return m.Chaos
+	}
+	return 0
+}
+
+func (m *PublicCity) GetPolitics() uint32 {
+	if m != nil {
+		return m.Politics
+	}
+	return 0
+}
+
+func (m *PublicCity) GetCult() uint32 {
+	if m != nil {
+		return m.Cult
+	}
+	return 0
+}
+
+func (m *PublicCity) GetEthny() uint32 {
+	if m != nil {
+		return m.Ethny
+	}
+	return 0
+}
+
+func (m *PublicCity) GetScore() int64 {
+	if m != nil {
+		return m.Score
+	}
+	return 0
+}
+
+func (m *PublicCity) GetArtifacts() []*Artifact {
+	if m != nil {
+		return m.Artifacts
+	}
+	return nil
+}
+
+type CityView struct {
+	Public        *PublicCity   `protobuf:"bytes,1,opt,name=public,proto3" json:"public,omitempty"`
+	Owner         string        `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
+	Deputy        string        `protobuf:"bytes,4,opt,name=deputy,proto3" json:"deputy,omitempty"`
+	TickMassacres uint32        `protobuf:"varint,12,opt,name=tickMassacres,proto3" json:"tickMassacres,omitempty"`
+	Auto          bool          `protobuf:"varint,13,opt,name=auto,proto3" json:"auto,omitempty"`
+	Politics      *CityPolitics `protobuf:"bytes,15,opt,name=politics,proto3" json:"politics,omitempty"`
+	// The resources owned by the City
+	Stock      *StockView      `protobuf:"bytes,16,opt,name=stock,proto3" json:"stock,omitempty"`
+	Production *ProductionView `protobuf:"bytes,17,opt,name=production,proto3" json:"production,omitempty"`
+	// All the things owned by the current city
+	Assets *CityAssets `protobuf:"bytes,18,opt,name=assets,proto3" json:"assets,omitempty"`
+	// All the things that the current may start to own
+	Evol *CityEvolution `protobuf:"bytes,19,opt,name=evol,proto3" json:"evol,omitempty"`
+	// How many rounds the epidemic will still last in the City
+	TickEpidemic uint32 `protobuf:"varint,20,opt,name=tickEpidemic,proto3" json:"tickEpidemic,omitempty"`
+	Health       int64  `protobuf:"varint,21,opt,name=health,proto3" json:"health,omitempty"`
+	// How the leftover stock is spent on the items in progress
+	Queue *ProductionQueueView `protobuf:"bytes,22,opt,name=queue,proto3" json:"queue,omitempty"`
+	// The rounds played in the Region of the City
+	Schedule             *ScheduleView `protobuf:"bytes,23,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *CityView) Reset()         { *m = CityView{} }
+func (m *CityView) String() string { return proto.CompactTextString(m) }
+func (*CityView) ProtoMessage()    {}
+func (*CityView) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6eef30384a8831dd, []int{38}
+}
+
+func (m *CityView) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CityView.Unmarshal(m, b)
+}
+func (m *CityView) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CityView.Marshal(b, m, deterministic)
+}
+func (m *CityView) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CityView.Merge(m, src)
+}
+func (m *CityView) XXX_Size() int {
+	return xxx_messageInfo_CityView.Size(m)
+}
+func (m *CityView) XXX_DiscardUnknown() {
+	xxx_messageInfo_CityView.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CityView proto.InternalMessageInfo
+
+func (m *CityView) GetPublic() *PublicCity {
+	if m != nil {
+		return m.Public
+	}
+	return nil
+}
+
+func (m *CityView) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *CityView) GetDeputy() string {
+	if m != nil {
+		return m.Deputy
+	}
+	return ""
+}
+
+func (m *CityView) GetTickMassacres() uint32 {
+	if m != nil {
+		return m.TickMassacres
+	}
+	return 0
+}
+
+func (m *CityView) GetAuto() bool {
+	if m != nil {
+		return m.Auto
+	}
+	return false
+}
+
+func (m *CityView) GetPolitics() *CityPolitics {
+	if m != nil {
+		return m.Politics
+	}
+	return nil
+}
+
+func (m *CityView) GetStock() *StockView {
+	if m != nil {
+		return m.Stock
+	}
+	return nil
+}
+
+func (m *CityView) GetProduction() *ProductionView {
+	if m != nil {
+		return m.Production
+	}
+	return nil
+}
+
+func (m *CityView) GetAssets() *CityAssets {
+	if m != nil {
+		return m.Assets
+	}
+	return nil
+}
+
+func (m *CityView) GetEvol() *CityEvolution {
+	if m != nil {
+		return m.Evol
+	}
+	return nil
+}
+
+func (m *CityView) GetTickEpidemic() uint32 {
+	if m != nil {
+		return m.TickEpidemic
+	}
+	return 0
+}
+
+func (m *CityView) GetHealth() int64 {
+	if m != nil {
+		return m.Health
+	}
+	return 0
+}
+
+func (m *CityView) GetQueue() *ProductionQueueView {
+	if m != nil {
+		return m.Queue
+	}
+	return nil
+}
+
+func (m *CityView) GetSchedule() *ScheduleView {
+	if m != nil {
+		return m.Schedule
+	}
+	return nil
+}
+
+// The ratio of the leftover stock each category of items may spend at each
+// production round. A null value means no limit.
+type QueueBudget struct {
+	Units                float64  `protobuf:"fixed64,1,opt,name=units,proto3" json:"units,omitempty"`
+	Buildings            float64  `protobuf:"fixed64,2,opt,name=buildings,proto3" json:"buildings,omitempty"`
+	Knowledges           float64  `protobuf:"fixed64,3,opt,name=knowledges,proto3" json:"knowledges,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *QueueBudget) Reset()         { *m = QueueBudget{} }
+func (m *QueueBudget) String() string { return proto.CompactTextString(m) }
+func (*QueueBudget) ProtoMessage()    {}
+func (*QueueBudget) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6eef30384a8831dd, []int{39}
+}
+
+func (m *QueueBudget) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueueBudget.Unmarshal(m, b)
+}
+func (m *QueueBudget) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueueBudget.Marshal(b, m, deterministic)
+}
+func (m *QueueBudget) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueueBudget.Merge(m, src)
+}
+func (m *QueueBudget) XXX_Size() int {
+	return xxx_messageInfo_QueueBudget.Size(m)
+}
+func (m *QueueBudget) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueueBudget.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueueBudget proto.InternalMessageInfo
+
+func (m *QueueBudget) GetUnits() float64 {
+	if m != nil {
+		return m.Units
+	}
+	return 0
+}
+
+func (m *QueueBudget) GetBuildings() float64 {
+	if m != nil {
+		return m.Buildings
+	}
+	return 0
+}
+
+func (m *QueueBudget) GetKnowledges() float64 {
+	if m != nil {
+		return m.Knowledges
+	}
+	return 0
+}
+
+type ProductionQueueView struct {
+	// IDs of the items in progress, in the order of priority
+	Order []string `protobuf:"bytes,1,rep,name=order,proto3" json:"order,omitempty"`
+	// IDs of the paused items
+	Paused               []string     `protobuf:"bytes,2,rep,name=paused,proto3" json:"paused,omitempty"`
+	Budget               *QueueBudget `protobuf:"bytes,3,opt,name=budget,proto3" json:"budget,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *ProductionQueueView) Reset()         { *m = ProductionQueueView{} }
+func (m *ProductionQueueView) String() string { return proto.CompactTextString(m) }
+func (*ProductionQueueView) ProtoMessage()    {}
+func (*ProductionQueueView) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6eef30384a8831dd, []int{40}
+}
+
+func (m *ProductionQueueView) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ProductionQueueView.Unmarshal(m, b)
+}
+func (m *ProductionQueueView) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ProductionQueueView.Marshal(b, m, deterministic)
+}
+func (m *ProductionQueueView) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProductionQueueView.Merge(m, src)
+}
+func (m *ProductionQueueView) XXX_Size() int {
+	return xxx_messageInfo_ProductionQueueView.Size(m)
+}
+func (m *ProductionQueueView) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProductionQueueView.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProductionQueueView proto.InternalMessageInfo
+
+func (m *ProductionQueueView) GetOrder() []string {
+	if m != nil {
+		return m.Order
+	}
+	return nil
+}
+
+func (m *ProductionQueueView) GetPaused() []string {
+	if m != nil {
+		return m.Paused
+	}
+	return nil
+}
+
+func (m *ProductionQueueView) GetBudget() *QueueBudget {
+	if m != nil {
+		return m.Budget
+	}
+	return nil
+}
+
+type QueueOrderReq struct {
+	City                 *CityId  `protobuf:"bytes,1,opt,name=city,proto3" json:"city,omitempty"`
+	Item                 []string `protobuf:"bytes,2,rep,name=item,proto3" json:"item,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *QueueOrderReq) Reset()         { *m = QueueOrderReq{} }
+func (m *QueueOrderReq) String() string { return proto.CompactTextString(m) }
+func (*QueueOrderReq) ProtoMessage()    {}
+func (*QueueOrderReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6eef30384a8831dd, []int{41}
+}
+
+func (m *QueueOrderReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueueOrderReq.Unmarshal(m, b)
+}
+func (m *QueueOrderReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueueOrderReq.Marshal(b, m, deterministic)
+}
+func (m *QueueOrderReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueueOrderReq.Merge(m, src)
+}
+func (m *QueueOrderReq) XXX_Size() int {
+	return xxx_messageInfo_QueueOrderReq.Size(m)
+}
+func (m *QueueOrderReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueueOrderReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueueOrderReq proto.InternalMessageInfo
+
+func (m *QueueOrderReq) GetCity() *CityId {
+	if m != nil {
+		return m.City
+	}
+	return nil
+}
+
+func (m *QueueOrderReq) GetItem() []string {
+	if m != nil {
+		return m.Item
+	}
+	return nil
+}
+
+type PauseItemReq struct {
+	City                 *CityId  `protobuf:"bytes,1,opt,name=city,proto3" json:"city,omitempty"`
+	Item                 string   `protobuf:"bytes,2,opt,name=item,proto3" json:"item,omitempty"`
+	Paused               bool     `protobuf:"varint,3,opt,name=paused,proto3" json:"paused,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PauseItemReq) Reset()         { *m = PauseItemReq{} }
+func (m *PauseItemReq) String() string { return proto.CompactTextString(m) }
+func (*PauseItemReq) ProtoMessage()    {}
+func (*PauseItemReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6eef30384a8831dd, []int{42}
+}
+
+func (m *PauseItemReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PauseItemReq.Unmarshal(m, b)
+}
+func (m *PauseItemReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PauseItemReq.Marshal(b, m, deterministic)
+}
+func (m *PauseItemReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PauseItemReq.Merge(m, src)
+}
+func (m *PauseItemReq) XXX_Size() int {
+	return xxx_messageInfo_PauseItemReq.Size(m)
+}
+func (m *PauseItemReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_PauseItemReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PauseItemReq proto.InternalMessageInfo
+
+func (m *PauseItemReq) GetCity() *CityId {
+	if m != nil {
+		return m.City
+	}
+	return nil
+}
+
+func (m *PauseItemReq) GetItem() string {
+	if m != nil {
+		return m.Item
+	}
+	return ""
+}
+
+func (m *PauseItemReq) GetPaused() bool {
+	if m != nil {
+		return m.Paused
+	}
+	return false
+}
+
+type CancelReq struct {
+	City                 *CityId  `protobuf:"bytes,1,opt,name=city,proto3" json:"city,omitempty"`
+	Item                 string   `protobuf:"bytes,2,opt,name=item,proto3" json:"item,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CancelReq) Reset()         { *m = CancelReq{} }
+func (m *CancelReq) String() string { return proto.CompactTextString(m) }
+func (*CancelReq) ProtoMessage()    {}
+func (*CancelReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6eef30384a8831dd, []int{43}
+}
+
+func (m *CancelReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CancelReq.Unmarshal(m, b)
+}
+func (m *CancelReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CancelReq.Marshal(b, m, deterministic)
+}
+func (m *CancelReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelReq.Merge(m, src)
+}
+func (m *CancelReq) XXX_Size() int {
+	return xxx_messageInfo_CancelReq.Size(m)
+}
+func (m *CancelReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CancelReq proto.InternalMessageInfo
+
+func (m *CancelReq) GetCity() *CityId {
+	if m != nil {
+		return m.City
+	}
+	return nil
+}
+
+func (m *CancelReq) GetItem() string {
+	if m != nil {
+		return m.Item
+	}
+	return ""
+}
+
+type CityClaimReq struct {
+	Region    string        `protobuf:"bytes,1,opt,name=region,proto3" json:"region,omitempty"`
+	Character string        `protobuf:"bytes,2,opt,name=character,proto3" json:"character,omitempty"`
+	Strategy  ClaimStrategy `protobuf:"varint,3,opt,name=strategy,proto3,enum=hege.reg.ClaimStrategy" json:"strategy,omitempty"`
+	// Only used by the ClaimCell strategy
+	Cell                 uint64   `protobuf:"varint,4,opt,name=cell,proto3" json:"cell,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CityClaimReq) Reset()         { *m = CityClaimReq{} }
+func (m *CityClaimReq) String() string { return proto.CompactTextString(m) }
+func (*CityClaimReq) ProtoMessage()    {}
+func (*CityClaimReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6eef30384a8831dd, []int{44}
+}
+
+func (m *CityClaimReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CityClaimReq.Unmarshal(m, b)
+}
+func (m *CityClaimReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CityClaimReq.Marshal(b, m, deterministic)
+}
+func (m *CityClaimReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CityClaimReq.Merge(m, src)
+}
+func (m *CityClaimReq) XXX_Size() int {
+	return xxx_messageInfo_CityClaimReq.Size(m)
+}
+func (m *CityClaimReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_CityClaimReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CityClaimReq proto.InternalMessageInfo
+
+func (m *CityClaimReq) GetRegion() string {
+	if m != nil {
+		return m.Region
+	}
+	return ""
+}
+
+func (m *CityClaimReq) GetCharacter() string {
+	if m != nil {
+		return m.Character
+	}
+	return ""
+}
+
+func (m *CityClaimReq) GetStrategy() ClaimStrategy {
+	if m != nil {
+		return m.Strategy
+	}
+	return ClaimStrategy_ClaimDefault
+}
+
+func (m *CityClaimReq) GetCell() uint64 {
+	if m != nil {
+		return m.Cell
+	}
+	return 0
+}
+
+type QueueBudgetReq struct {
+	City                 *CityId      `protobuf:"bytes,1,opt,name=city,proto3" json:"city,omitempty"`
+	Budget               *QueueBudget `protobuf:"bytes,2,opt,name=budget,proto3" json:"budget,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *QueueBudgetReq) Reset()         { *m = QueueBudgetReq{} }
+func (m *QueueBudgetReq) String() string { return proto.CompactTextString(m) }
+func (*QueueBudgetReq) ProtoMessage()    {}
+func (*QueueBudgetReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6eef30384a8831dd, []int{45}
+}
+
+func (m *QueueBudgetReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueueBudgetReq.Unmarshal(m, b)
+}
+func (m *QueueBudgetReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueueBudgetReq.Marshal(b, m, deterministic)
+}
+func (m *QueueBudgetReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueueBudgetReq.Merge(m, src)
+}
+func (m *QueueBudgetReq) XXX_Size() int {
+	return xxx_messageInfo_QueueBudgetReq.Size(m)
+}
+func (m *QueueBudgetReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueueBudgetReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueueBudgetReq proto.InternalMessageInfo
+
+func (m *QueueBudgetReq) GetCity() *CityId {
+	if m != nil {
+		return m.City
+	}
+	return nil
+}
+
+func (m *QueueBudgetReq) GetBudget() *QueueBudget {
+	if m != nil {
+		return m.Budget
+	}
+	return nil
+}
+
+type StudyReq struct {
+	City                 *CityId  `protobuf:"bytes,1,opt,name=city,proto3" json:"city,omitempty"`
+	KnowledgeType        uint64   `protobuf:"varint,2,opt,name=knowledgeType,proto3" json:"knowledgeType,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StudyReq) Reset()         { *m = StudyReq{} }
+func (m *StudyReq) String() string { return proto.CompactTextString(m) }
+func (*StudyReq) ProtoMessage()    {}
+func (*StudyReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6eef30384a8831dd, []int{46}
+}
+
+func (m *StudyReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StudyReq.Unmarshal(m, b)
+}
+func (m *StudyReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StudyReq.Marshal(b, m, deterministic)
+}
+func (m *StudyReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StudyReq.Merge(m, src)
+}
+func (m *StudyReq) XXX_Size() int {
+	return xxx_messageInfo_StudyReq.Size(m)
+}
+func (m *StudyReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_StudyReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StudyReq proto.InternalMessageInfo
+
+func (m *StudyReq) GetCity() *CityId {
+	if m != nil {
+		return m.City
+	}
+	return nil
+}
+
+func (m *StudyReq) GetKnowledgeType() uint64 {
+	if m != nil {
+		return m.KnowledgeType
+	}
+	return 0
+}
+
+type TrainReq struct {
+	City                 *CityId  `protobuf:"bytes,1,opt,name=city,proto3" json:"city,omitempty"`
+	UnitType             uint64   `protobuf:"varint,2,opt,name=unitType,proto3" json:"unitType,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TrainReq) Reset()         { *m = TrainReq{} }
+func (m *TrainReq) String() string { return proto.CompactTextString(m) }
+func (*TrainReq) ProtoMessage()    {}
+func (*TrainReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6eef30384a8831dd, []int{47}
+}
+
+func (m *TrainReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TrainReq.Unmarshal(m, b)
+}
+func (m *TrainReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TrainReq.Marshal(b, m, deterministic)
+}
+func (m *TrainReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TrainReq.Merge(m, src)
+}
+func (m *TrainReq) XXX_Size() int {
+	return xxx_messageInfo_TrainReq.Size(m)
+}
+func (m *TrainReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_TrainReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TrainReq proto.InternalMessageInfo
+
+func (m *TrainReq) GetCity() *CityId {
+	if m != nil {
+		return m.City
+	}
+	return nil
+}
+
+func (m *TrainReq) GetUnitType() uint64 {
+	if m != nil {
+		return m.UnitType
+	}
+	return 0
+}
+
+type BuildReq struct {
+	City                 *CityId  `protobuf:"bytes,1,opt,name=city,proto3" json:"city,omitempty"`
+	BuildingType         uint64   `protobuf:"varint,2,opt,name=buildingType,proto3" json:"buildingType,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BuildReq) Reset()         { *m = BuildReq{} }
+func (m *BuildReq) String() string { return proto.CompactTextString(m) }
+func (*BuildReq) ProtoMessage()    {}
+func (*BuildReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6eef30384a8831dd, []int{48}
+}
+
+func (m *BuildReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BuildReq.Unmarshal(m, b)
+}
+func (m *BuildReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BuildReq.Marshal(b, m, deterministic)
+}
+func (m *BuildReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BuildReq.Merge(m, src)
+}
+func (m *BuildReq) XXX_Size() int {
+	return xxx_messageInfo_BuildReq.Size(m)
+}
+func (m *BuildReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_BuildReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BuildReq proto.InternalMessageInfo
+
+func (m *BuildReq) GetCity() *CityId {
+	if m != nil {
+		return m.City
+	}
+	return nil
+}
+
+func (m *BuildReq) GetBuildingType() uint64 {
+	if m != nil {
+		return m.BuildingType
+	}
+	return 0
+}
+
+type CreateTransportReq struct {
+	City                 *CityId       `protobuf:"bytes,1,opt,name=city,proto3" json:"city,omitempty"`
+	Name                 string        `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Target               uint64        `protobuf:"varint,3,opt,name=target,proto3" json:"target,omitempty"`
+	Stock                *ResourcesAbs `protobuf:"bytes,4,opt,name=stock,proto3" json:"stock,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *CreateTransportReq) Reset()         { *m = CreateTransportReq{} }
+func (m *CreateTransportReq) String() string { return proto.CompactTextString(m) }
+func (*CreateTransportReq) ProtoMessage()    {}
+func (*CreateTransportReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6eef30384a8831dd, []int{49}
+}
+
+func (m *CreateTransportReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateTransportReq.Unmarshal(m, b)
+}
+func (m *CreateTransportReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateTransportReq.Marshal(b, m, deterministic)
+}
+func (m *CreateTransportReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateTransportReq.Merge(m, src)
+}
+func (m *CreateTransportReq) XXX_Size() int {
+	return xxx_messageInfo_CreateTransportReq.Size(m)
+}
+func (m *CreateTransportReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateTransportReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateTransportReq proto.InternalMessageInfo
+
+func (m *CreateTransportReq) GetCity() *CityId {
+	if m != nil {
+		return m.City
+	}
+	return nil
+}
+
+func (m *CreateTransportReq) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *CreateTransportReq) GetTarget() uint64 {
+	if m != nil {
+		return m.Target
+	}
+	return 0
+}
+
+func (m *CreateTransportReq) GetStock() *ResourcesAbs {
+	if m != nil {
+		return m.Stock
+	}
+	return nil
+}
+
+type CreateArmyReq struct {
+	City                 *CityId  `protobuf:"bytes,1,opt,name=city,proto3" json:"city,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Unit                 []uint64 `protobuf:"varint,3,rep,packed,name=unit,proto3" json:"unit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateArmyReq) Reset()         { *m = CreateArmyReq{} }
+func (m *CreateArmyReq) String() string { return proto.CompactTextString(m) }
+func (*CreateArmyReq) ProtoMessage()    {}
+func (*CreateArmyReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6eef30384a8831dd, []int{50}
+}
+
+func (m *CreateArmyReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateArmyReq.Unmarshal(m, b)
+}
+func (m *CreateArmyReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateArmyReq.Marshal(b, m, deterministic)
+}
+func (m *CreateArmyReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateArmyReq.Merge(m, src)
+}
+func (m *CreateArmyReq) XXX_Size() int {
+	return xxx_messageInfo_CreateArmyReq.Size(m)
+}
+func (m *CreateArmyReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateArmyReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateArmyReq proto.InternalMessageInfo
+
+func (m *CreateArmyReq) GetCity() *CityId {
+	if m != nil {
+		return m.City
+	}
+	return nil
+}
+
+func (m *CreateArmyReq) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *CreateArmyReq) GetUnit() []uint64 {
+	if m != nil {
+		return m.Unit
+	}
+	return nil
+}
+
+type TransferUnitReq struct {
+	City                 *CityId  `protobuf:"bytes,1,opt,name=city,proto3" json:"city,omitempty"`
+	Army                 string   `protobuf:"bytes,3,opt,name=army,proto3" json:"army,omitempty"`
+	Unit                 []uint64 `protobuf:"varint,4,rep,packed,name=unit,proto3" json:"unit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TransferUnitReq) Reset()         { *m = TransferUnitReq{} }
+func (m *TransferUnitReq) String() string { return proto.CompactTextString(m) }
+func (*TransferUnitReq) ProtoMessage()    {}
+func (*TransferUnitReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6eef30384a8831dd, []int{51}
+}
+
+func (m *TransferUnitReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TransferUnitReq.Unmarshal(m, b)
+}
+func (m *TransferUnitReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TransferUnitReq.Marshal(b, m, deterministic)
+}
+func (m *TransferUnitReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransferUnitReq.Merge(m, src)
+}
+func (m *TransferUnitReq) XXX_Size() int {
+	return xxx_messageInfo_TransferUnitReq.Size(m)
+}
+func (m *TransferUnitReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransferUnitReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransferUnitReq proto.InternalMessageInfo
+
+func (m *TransferUnitReq) GetCity() *CityId {
+	if m != nil {
+		return m.City
+	}
+	return nil
+}
+
+func (m *TransferUnitReq) GetArmy() string {
+	if m != nil {
+		return m.Army
+	}
+	return ""
+}
+
+func (m *TransferUnitReq) GetUnit() []uint64 {
+	if m != nil {
+		return m.Unit
+	}
+	return nil
+}
+
+type TransferResourcesReq struct {
+	City                 *CityId       `protobuf:"bytes,1,opt,name=city,proto3" json:"city,omitempty"`
+	Army                 string        `protobuf:"bytes,3,opt,name=army,proto3" json:"army,omitempty"`
+	Stock                *ResourcesAbs `protobuf:"bytes,4,opt,name=stock,proto3" json:"stock,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *TransferResourcesReq) Reset()         { *m = TransferResourcesReq{} }
+func (m *TransferResourcesReq) String() string { return proto.CompactTextString(m) }
+func (*TransferResourcesReq) ProtoMessage()    {}
+func (*TransferResourcesReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6eef30384a8831dd, []int{52}
+}
+
+func (m *TransferResourcesReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TransferResourcesReq.Unmarshal(m, b)
+}
+func (m *TransferResourcesReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TransferResourcesReq.Marshal(b, m, deterministic)
+}
+func (m *TransferResourcesReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransferResourcesReq.Merge(m, src)
+}
+func (m *TransferResourcesReq) XXX_Size() int {
+	return xxx_messageInfo_TransferResourcesReq.Size(m)
+}
+func (m *TransferResourcesReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransferResourcesReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransferResourcesReq proto.InternalMessageInfo
+
+func (m *TransferResourcesReq) GetCity() *CityId {
+	if m != nil {
+		return m.City
+	}
+	return nil
+}
+
+func (m *TransferResourcesReq) GetArmy() string {
+	if m != nil {
+		return m.Army
+	}
+	return ""
+}
+
+func (m *TransferResourcesReq) GetStock() *ResourcesAbs {
+	if m != nil {
+		return m.Stock
+	}
+	return nil
+}
+
+type TransferArtifactReq struct {
+	City                 *CityId  `protobuf:"bytes,1,opt,name=city,proto3" json:"city,omitempty"`
+	Army                 string   `protobuf:"bytes,2,opt,name=army,proto3" json:"army,omitempty"`
+	Artifact             string   `protobuf:"bytes,3,opt,name=artifact,proto3" json:"artifact,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TransferArtifactReq) Reset()         { *m = TransferArtifactReq{} }
+func (m *TransferArtifactReq) String() string { return proto.CompactTextString(m) }
+func (*TransferArtifactReq) ProtoMessage()    {}
+func (*TransferArtifactReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6eef30384a8831dd, []int{53}
+}
+
+func (m *TransferArtifactReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TransferArtifactReq.Unmarshal(m, b)
+}
+func (m *TransferArtifactReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TransferArtifactReq.Marshal(b, m, deterministic)
+}
+func (m *TransferArtifactReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransferArtifactReq.Merge(m, src)
+}
+func (m *TransferArtifactReq) XXX_Size() int {
+	return xxx_messageInfo_TransferArtifactReq.Size(m)
+}
+func (m *TransferArtifactReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransferArtifactReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransferArtifactReq proto.InternalMessageInfo
+
+func (m *TransferArtifactReq) GetCity() *CityId {
+	if m != nil {
+		return m.City
+	}
+	return nil
+}
+
+func (m *TransferArtifactReq) GetArmy() string {
+	if m != nil {
+		return m.Army
+	}
+	return ""
+}
+
+func (m *TransferArtifactReq) GetArtifact() string {
+	if m != nil {
+		return m.Artifact
+	}
+	return ""
+}
+
+type ArtifactCreateReq struct {
+	Region               string   `protobuf:"bytes,1,opt,name=region,proto3" json:"region,omitempty"`
+	City                 uint64   `protobuf:"varint,2,opt,name=city,proto3" json:"city,omitempty"`
+	Type                 uint64   `protobuf:"varint,3,opt,name=type,proto3" json:"type,omitempty"`
+	Name                 string   `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ArtifactCreateReq) Reset()         { *m = ArtifactCreateReq{} }
+func (m *ArtifactCreateReq) String() string { return proto.CompactTextString(m) }
+func (*ArtifactCreateReq) ProtoMessage()    {}
+func (*ArtifactCreateReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6eef30384a8831dd, []int{54}
+}
+
+func (m *ArtifactCreateReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ArtifactCreateReq.Unmarshal(m, b)
+}
+func (m *ArtifactCreateReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ArtifactCreateReq.Marshal(b, m, deterministic)
+}
+func (m *ArtifactCreateReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArtifactCreateReq.Merge(m, src)
+}
+func (m *ArtifactCreateReq) XXX_Size() int {
+	return xxx_messageInfo_ArtifactCreateReq.Size(m)
+}
+func (m *ArtifactCreateReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArtifactCreateReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ArtifactCreateReq proto.InternalMessageInfo
+
+func (m *ArtifactCreateReq) GetRegion() string {
+	if m != nil {
+		return m.Region
+	}
+	return ""
+}
+
+func (m *ArtifactCreateReq) GetCity() uint64 {
+	if m != nil {
+		return m.City
+	}
+	return 0
+}
+
+func (m *ArtifactCreateReq) GetType() uint64 {
+	if m != nil {
+		return m.Type
+	}
+	return 0
+}
+
+func (m *ArtifactCreateReq) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+type CityDeputyReq struct {
+	City                 *CityId  `protobuf:"bytes,1,opt,name=city,proto3" json:"city,omitempty"`
+	Deputy               string   `protobuf:"bytes,2,opt,name=deputy,proto3" json:"deputy,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CityDeputyReq) Reset()         { *m = CityDeputyReq{} }
+func (m *CityDeputyReq) String() string { return proto.CompactTextString(m) }
+func (*CityDeputyReq) ProtoMessage()    {}
+func (*CityDeputyReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6eef30384a8831dd, []int{55}
+}
+
+func (m *CityDeputyReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CityDeputyReq.Unmarshal(m, b)
+}
+func (m *CityDeputyReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CityDeputyReq.Marshal(b, m, deterministic)
+}
+func (m *CityDeputyReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CityDeputyReq.Merge(m, src)
+}
+func (m *CityDeputyReq) XXX_Size() int {
+	return xxx_messageInfo_CityDeputyReq.Size(m)
+}
+func (m *CityDeputyReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_CityDeputyReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CityDeputyReq proto.InternalMessageInfo
+
+func (m *CityDeputyReq) GetCity() *CityId {
+	if m != nil {
+		return m.City
+	}
+	return nil
+}
+
+func (m *CityDeputyReq) GetDeputy() string {
+	if m != nil {
+		return m.Deputy
+	}
+	return ""
+}
+
+type CityTransferReq struct {
+	City                 *CityId  `protobuf:"bytes,1,opt,name=city,proto3" json:"city,omitempty"`
+	Owner                string   `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CityTransferReq) Reset()         { *m = CityTransferReq{} }
+func (m *CityTransferReq) String() string { return proto.CompactTextString(m) }
+func (*CityTransferReq) ProtoMessage()    {}
+func (*CityTransferReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6eef30384a8831dd, []int{56}
+}
+
+func (m *CityTransferReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CityTransferReq.Unmarshal(m, b)
+}
+func (m *CityTransferReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CityTransferReq.Marshal(b, m, deterministic)
+}
+func (m *CityTransferReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CityTransferReq.Merge(m, src)
+}
+func (m *CityTransferReq) XXX_Size() int {
+	return xxx_messageInfo_CityTransferReq.Size(m)
+}
+func (m *CityTransferReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_CityTransferReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CityTransferReq proto.InternalMessageInfo
+
+func (m *CityTransferReq) GetCity() *CityId {
+	if m != nil {
+		return m.City
+	}
+	return nil
+}
+
+func (m *CityTransferReq) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+type CityRenameReq struct {
+	City                 *CityId  `protobuf:"bytes,1,opt,name=city,proto3" json:"city,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CityRenameReq) Reset()         { *m = CityRenameReq{} }
+func (m *CityRenameReq) String() string { return proto.CompactTextString(m) }
+func (*CityRenameReq) ProtoMessage()    {}
+func (*CityRenameReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6eef30384a8831dd, []int{57}
+}
+
+func (m *CityRenameReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CityRenameReq.Unmarshal(m, b)
+}
+func (m *CityRenameReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CityRenameReq.Marshal(b, m, deterministic)
+}
+func (m *CityRenameReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CityRenameReq.Merge(m, src)
+}
+func (m *CityRenameReq) XXX_Size() int {
+	return xxx_messageInfo_CityRenameReq.Size(m)
+}
+func (m *CityRenameReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_CityRenameReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CityRenameReq proto.InternalMessageInfo
+
+func (m *CityRenameReq) GetCity() *CityId {
+	if m != nil {
+		return m.City
+	}
+	return nil
+}
+
+func (m *CityRenameReq) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+type DismantleReq struct {
+	City                 *CityId  `protobuf:"bytes,1,opt,name=city,proto3" json:"city,omitempty"`
+	Building             string   `protobuf:"bytes,2,opt,name=building,proto3" json:"building,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DismantleReq) Reset()         { *m = DismantleReq{} }
+func (m *DismantleReq) String() string { return proto.CompactTextString(m) }
+func (*DismantleReq) ProtoMessage()    {}
+func (*DismantleReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6eef30384a8831dd, []int{58}
+}
+
+func (m *DismantleReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DismantleReq.Unmarshal(m, b)
+}
+func (m *DismantleReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DismantleReq.Marshal(b, m, deterministic)
+}
+func (m *DismantleReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DismantleReq.Merge(m, src)
+}
+func (m *DismantleReq) XXX_Size() int {
+	return xxx_messageInfo_DismantleReq.Size(m)
+}
+func (m *DismantleReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_DismantleReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DismantleReq proto.InternalMessageInfo
+
+func (m *DismantleReq) GetCity() *CityId {
+	if m != nil {
+		return m.City
+	}
+	return nil
+}
+
+func (m *DismantleReq) GetBuilding() string {
+	if m != nil {
+		return m.Building
+	}
+	return ""
+}
+
+type DisbandUnitsReq struct {
+	City                 *CityId  `protobuf:"bytes,1,opt,name=city,proto3" json:"city,omitempty"`
+	Unit                 []string `protobuf:"bytes,2,rep,name=unit,proto3" json:"unit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DisbandUnitsReq) Reset()         { *m = DisbandUnitsReq{} }
+func (m *DisbandUnitsReq) String() string { return proto.CompactTextString(m) }
+func (*DisbandUnitsReq) ProtoMessage()    {}
+func (*DisbandUnitsReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6eef30384a8831dd, []int{59}
+}
+
+func (m *DisbandUnitsReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DisbandUnitsReq.Unmarshal(m, b)
+}
+func (m *DisbandUnitsReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DisbandUnitsReq.Marshal(b, m, deterministic)
+}
+func (m *DisbandUnitsReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DisbandUnitsReq.Merge(m, src)
+}
+func (m *DisbandUnitsReq) XXX_Size() int {
+	return xxx_messageInfo_DisbandUnitsReq.Size(m)
+}
+func (m *DisbandUnitsReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_DisbandUnitsReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DisbandUnitsReq proto.InternalMessageInfo
+
+func (m *DisbandUnitsReq) GetCity() *CityId {
+	if m != nil {
+		return m.City
+	}
+	return nil
+}
+
+func (m *DisbandUnitsReq) GetUnit() []string {
+	if m != nil {
+		return m.Unit
+	}
+	return nil
+}
+
+type CityAutoReq struct {
+	City                 *CityId  `protobuf:"bytes,1,opt,name=city,proto3" json:"city,omitempty"`
+	Auto                 bool     `protobuf:"varint,2,opt,name=auto,proto3" json:"auto,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CityAutoReq) Reset()         { *m = CityAutoReq{} }
+func (m *CityAutoReq) String() string { return proto.CompactTextString(m) }
+func (*CityAutoReq) ProtoMessage()    {}
+func (*CityAutoReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6eef30384a8831dd, []int{60}
+}
+
+func (m *CityAutoReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CityAutoReq.Unmarshal(m, b)
+}
+func (m *CityAutoReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CityAutoReq.Marshal(b, m, deterministic)
+}
+func (m *CityAutoReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CityAutoReq.Merge(m, src)
+}
+func (m *CityAutoReq) XXX_Size() int {
+	return xxx_messageInfo_CityAutoReq.Size(m)
+}
+func (m *CityAutoReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_CityAutoReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CityAutoReq proto.InternalMessageInfo
+
+func (m *CityAutoReq) GetCity() *CityId {
+	if m != nil {
+		return m.City
+	}
+	return nil
+}
+
+func (m *CityAutoReq) GetAuto() bool {
+	if m != nil {
+		return m.Auto
+	}
+	return false
+}
+
+type SpyReq struct {
+	City                 *CityId  `protobuf:"bytes,1,opt,name=city,proto3" json:"city,omitempty"`
+	Target               uint64   `protobuf:"varint,2,opt,name=target,proto3" json:"target,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SpyReq) Reset()         { *m = SpyReq{} }
+func (m *SpyReq) String() string { return proto.CompactTextString(m) }
+func (*SpyReq) ProtoMessage()    {}
+func (*SpyReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6eef30384a8831dd, []int{61}
+}
+
+func (m *SpyReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SpyReq.Unmarshal(m, b)
+}
+func (m *SpyReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SpyReq.Marshal(b, m, deterministic)
+}
+func (m *SpyReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SpyReq.Merge(m, src)
+}
+func (m *SpyReq) XXX_Size() int {
+	return xxx_messageInfo_SpyReq.Size(m)
+}
+func (m *SpyReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_SpyReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SpyReq proto.InternalMessageInfo
+
+func (m *SpyReq) GetCity() *CityId {
+	if m != nil {
+		return m.City
+	}
+	return nil
+}
+
+func (m *SpyReq) GetTarget() uint64 {
+	if m != nil {
+		return m.Target
+	}
+	return 0
+}
+
+type SpyStealReq struct {
+	City                 *CityId  `protobuf:"bytes,1,opt,name=city,proto3" json:"city,omitempty"`
+	Target               uint64   `protobuf:"varint,2,opt,name=target,proto3" json:"target,omitempty"`
+	KnowledgeType        uint64   `protobuf:"varint,3,opt,name=knowledgeType,proto3" json:"knowledgeType,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SpyStealReq) Reset()         { *m = SpyStealReq{} }
+func (m *SpyStealReq) String() string { return proto.CompactTextString(m) }
+func (*SpyStealReq) ProtoMessage()    {}
+func (*SpyStealReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6eef30384a8831dd, []int{62}
+}
+
+func (m *SpyStealReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SpyStealReq.Unmarshal(m, b)
+}
+func (m *SpyStealReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SpyStealReq.Marshal(b, m, deterministic)
+}
+func (m *SpyStealReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SpyStealReq.Merge(m, src)
+}
+func (m *SpyStealReq) XXX_Size() int {
+	return xxx_messageInfo_SpyStealReq.Size(m)
+}
+func (m *SpyStealReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_SpyStealReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SpyStealReq proto.InternalMessageInfo
+
+func (m *SpyStealReq) GetCity() *CityId {
+	if m != nil {
+		return m.City
+	}
+	return nil
+}
+
+func (m *SpyStealReq) GetTarget() uint64 {
+	if m != nil {
+		return m.Target
+	}
+	return 0
+}
+
+func (m *SpyStealReq) GetKnowledgeType() uint64 {
+	if m != nil {
+		return m.KnowledgeType
+	}
+	return 0
+}
+
+type SpyReport struct {
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	// Only set in case of a successful reveal
+	View                 *CityView `protobuf:"bytes,2,opt,name=view,proto3" json:"view,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *SpyReport) Reset()         { *m = SpyReport{} }
+func (m *SpyReport) String() string { return proto.CompactTextString(m) }
+func (*SpyReport) ProtoMessage()    {}
+func (*SpyReport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6eef30384a8831dd, []int{63}
+}
+
+func (m *SpyReport) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SpyReport.Unmarshal(m, b)
+}
+func (m *SpyReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SpyReport.Marshal(b, m, deterministic)
+}
+func (m *SpyReport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SpyReport.Merge(m, src)
+}
+func (m *SpyReport) XXX_Size() int {
+	return xxx_messageInfo_SpyReport.Size(m)
+}
+func (m *SpyReport) XXX_DiscardUnknown() {
+	xxx_messageInfo_SpyReport.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SpyReport proto.InternalMessageInfo
+
+func (m *SpyReport) GetSuccess() bool {
+	if m != nil {
+		return m.Success
+	}
+	return false
+}
+
+func (m *SpyReport) GetView() *CityView {
+	if m != nil {
+		return m.View
+	}
+	return nil
+}
+
+type EpidemicSeedReq struct {
+	Region               string   `protobuf:"bytes,1,opt,name=region,proto3" json:"region,omitempty"`
+	City                 uint64   `protobuf:"varint,2,opt,name=city,proto3" json:"city,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EpidemicSeedReq) Reset()         { *m = EpidemicSeedReq{} }
+func (m *EpidemicSeedReq) String() string { return proto.CompactTextString(m) }
+func (*EpidemicSeedReq) ProtoMessage()    {}
+func (*EpidemicSeedReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6eef30384a8831dd, []int{64}
+}
+
+func (m *EpidemicSeedReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EpidemicSeedReq.Unmarshal(m, b)
+}
+func (m *EpidemicSeedReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EpidemicSeedReq.Marshal(b, m, deterministic)
+}
+func (m *EpidemicSeedReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EpidemicSeedReq.Merge(m, src)
+}
+func (m *EpidemicSeedReq) XXX_Size() int {
+	return xxx_messageInfo_EpidemicSeedReq.Size(m)
+}
+func (m *EpidemicSeedReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_EpidemicSeedReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EpidemicSeedReq proto.InternalMessageInfo
+
+func (m *EpidemicSeedReq) GetRegion() string {
+	if m != nil {
+		return m.Region
+	}
+	return ""
+}
+
+func (m *EpidemicSeedReq) GetCity() uint64 {
+	if m != nil {
+		return m.City
+	}
+	return 0
+}
+
+type CitiesByCharReq struct {
+	Region               string   `protobuf:"bytes,1,opt,name=region,proto3" json:"region,omitempty"`
+	Character            string   `protobuf:"bytes,2,opt,name=character,proto3" json:"character,omitempty"`
+	Marker               uint64   `protobuf:"varint,3,opt,name=marker,proto3" json:"marker,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CitiesByCharReq) Reset()         { *m = CitiesByCharReq{} }
+func (m *CitiesByCharReq) String() string { return proto.CompactTextString(m) }
+func (*CitiesByCharReq) ProtoMessage()    {}
+func (*CitiesByCharReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6eef30384a8831dd, []int{65}
+}
+
+func (m *CitiesByCharReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CitiesByCharReq.Unmarshal(m, b)
+}
+func (m *CitiesByCharReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CitiesByCharReq.Marshal(b, m, deterministic)
+}
+func (m *CitiesByCharReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CitiesByCharReq.Merge(m, src)
+}
+func (m *CitiesByCharReq) XXX_Size() int {
+	return xxx_messageInfo_CitiesByCharReq.Size(m)
+}
+func (m *CitiesByCharReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_CitiesByCharReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CitiesByCharReq proto.InternalMessageInfo
+
+func (m *CitiesByCharReq) GetRegion() string {
+	if m != nil {
+		return m.Region
+	}
+	return ""
+}
+
+func (m *CitiesByCharReq) GetCharacter() string {
+	if m != nil {
+		return m.Character
+	}
+	return ""
+}
+
+func (m *CitiesByCharReq) GetMarker() uint64 {
+	if m != nil {
+		return m.Marker
+	}
+	return 0
+}
+
+type PaginatedQuery struct {
+	Region               string   `protobuf:"bytes,1,opt,name=region,proto3" json:"region,omitempty"`
+	Marker               uint64   `protobuf:"varint,2,opt,name=marker,proto3" json:"marker,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PaginatedQuery) Reset()         { *m = PaginatedQuery{} }
+func (m *PaginatedQuery) String() string { return proto.CompactTextString(m) }
+func (*PaginatedQuery) ProtoMessage()    {}
+func (*PaginatedQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6eef30384a8831dd, []int{66}
+}
+
+func (m *PaginatedQuery) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PaginatedQuery.Unmarshal(m, b)
+}
+func (m *PaginatedQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PaginatedQuery.Marshal(b, m, deterministic)
+}
+func (m *PaginatedQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PaginatedQuery.Merge(m, src)
+}
+func (m *PaginatedQuery) XXX_Size() int {
+	return xxx_messageInfo_PaginatedQuery.Size(m)
+}
+func (m *PaginatedQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_PaginatedQuery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PaginatedQuery proto.InternalMessageInfo
+
+func (m *PaginatedQuery) GetRegion() string {
+	if m != nil {
+		return m.Region
+	}
+	return ""
+}
+
+func (m *PaginatedQuery) GetMarker() uint64 {
+	if m != nil {
+		return m.Marker
+	}
+	return 0
+}
+
+type Artifact struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name                 string   `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Visible              bool     `protobuf:"varint,4,opt,name=visible,proto3" json:"visible,omitempty"`
+	Type                 uint64   `protobuf:"varint,5,opt,name=type,proto3" json:"type,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Artifact) Reset()         { *m = Artifact{} }
+func (m *Artifact) String() string { return proto.CompactTextString(m) }
+func (*Artifact) ProtoMessage()    {}
+func (*Artifact) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6eef30384a8831dd, []int{67}
+}
+
+func (m *Artifact) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Artifact.Unmarshal(m, b)
+}
+func (m *Artifact) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Artifact.Marshal(b, m, deterministic)
+}
+func (m *Artifact) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Artifact.Merge(m, src)
+}
+func (m *Artifact) XXX_Size() int {
+	return xxx_messageInfo_Artifact.Size(m)
+}
+func (m *Artifact) XXX_DiscardUnknown() {
+	xxx_messageInfo_Artifact.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Artifact proto.InternalMessageInfo
+
+func (m *Artifact) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *Artifact) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Artifact) GetVisible() bool {
+	if m != nil {
+		return m.Visible
+	}
+	return false
+}
+
+func (m *Artifact) GetType() uint64 {
+	if m != nil {
+		return m.Type
+	}
+	return 0
+}
+
+type PopularityChange struct {
+	// Why the popularity changed (e.g. Build, Train, Death, ArmyCreate...)
+	Reason string `protobuf:"bytes,1,opt,name=reason,proto3" json:"reason,omitempty"`
+	// The type of the item that caused the change, or 0
+	IdType               uint64   `protobuf:"varint,2,opt,name=idType,proto3" json:"idType,omitempty"`
+	Delta                int64    `protobuf:"varint,3,opt,name=delta,proto3" json:"delta,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PopularityChange) Reset()         { *m = PopularityChange{} }
+func (m *PopularityChange) String() string { return proto.CompactTextString(m) }
+func (*PopularityChange) ProtoMessage()    {}
+func (*PopularityChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6eef30384a8831dd, []int{68}
+}
+
+func (m *PopularityChange) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PopularityChange.Unmarshal(m, b)
+}
+func (m *PopularityChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PopularityChange.Marshal(b, m, deterministic)
+}
+func (m *PopularityChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PopularityChange.Merge(m, src)
+}
+func (m *PopularityChange) XXX_Size() int {
+	return xxx_messageInfo_PopularityChange.Size(m)
+}
+func (m *PopularityChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_PopularityChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PopularityChange proto.InternalMessageInfo
+
+func (m *PopularityChange) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *PopularityChange) GetIdType() uint64 {
+	if m != nil {
+		return m.IdType
+	}
+	return 0
+}
+
+func (m *PopularityChange) GetDelta() int64 {
+	if m != nil {
+		return m.Delta
+	}
+	return 0
+}
+
+type TreatyView struct {
+	Id                   string     `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Kind                 TreatyKind `protobuf:"varint,2,opt,name=kind,proto3,enum=hege.reg.TreatyKind" json:"kind,omitempty"`
+	Proposer             string     `protobuf:"bytes,3,opt,name=proposer,proto3" json:"proposer,omitempty"`
+	Target               string     `protobuf:"bytes,4,opt,name=target,proto3" json:"target,omitempty"`
+	Active               bool       `protobuf:"varint,5,opt,name=active,proto3" json:"active,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *TreatyView) Reset()         { *m = TreatyView{} }
+func (m *TreatyView) String() string { return proto.CompactTextString(m) }
+func (*TreatyView) ProtoMessage()    {}
+func (*TreatyView) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6eef30384a8831dd, []int{69}
+}
+
+func (m *TreatyView) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TreatyView.Unmarshal(m, b)
+}
+func (m *TreatyView) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TreatyView.Marshal(b, m, deterministic)
+}
+func (m *TreatyView) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TreatyView.Merge(m, src)
+}
+func (m *TreatyView) XXX_Size() int {
+	return xxx_messageInfo_TreatyView.Size(m)
+}
+func (m *TreatyView) XXX_DiscardUnknown() {
+	xxx_messageInfo_TreatyView.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TreatyView proto.InternalMessageInfo
+
+func (m *TreatyView) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *TreatyView) GetKind() TreatyKind {
+	if m != nil {
+		return m.Kind
+	}
+	return TreatyKind_NoTreaty
+}
+
+func (m *TreatyView) GetProposer() string {
+	if m != nil {
+		return m.Proposer
+	}
+	return ""
+}
+
+func (m *TreatyView) GetTarget() string {
+	if m != nil {
+		return m.Target
+	}
+	return ""
+}
+
+func (m *TreatyView) GetActive() bool {
+	if m != nil {
+		return m.Active
+	}
+	return false
+}
+
+type TreatyListReq struct {
+	Region               string   `protobuf:"bytes,1,opt,name=region,proto3" json:"region,omitempty"`
+	Character            string   `protobuf:"bytes,2,opt,name=character,proto3" json:"character,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TreatyListReq) Reset()         { *m = TreatyListReq{} }
+func (m *TreatyListReq) String() string { return proto.CompactTextString(m) }
+func (*TreatyListReq) ProtoMessage()    {}
+func (*TreatyListReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6eef30384a8831dd, []int{70}
+}
+
+func (m *TreatyListReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TreatyListReq.Unmarshal(m, b)
+}
+func (m *TreatyListReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TreatyListReq.Marshal(b, m, deterministic)
+}
+func (m *TreatyListReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TreatyListReq.Merge(m, src)
+}
+func (m *TreatyListReq) XXX_Size() int {
+	return xxx_messageInfo_TreatyListReq.Size(m)
+}
+func (m *TreatyListReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_TreatyListReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TreatyListReq proto.InternalMessageInfo
+
+func (m *TreatyListReq) GetRegion() string {
+	if m != nil {
+		return m.Region
+	}
+	return ""
+}
+
+func (m *TreatyListReq) GetCharacter() string {
+	if m != nil {
+		return m.Character
+	}
+	return ""
+}
+
+type TreatyProposeReq struct {
+	Region               string     `protobuf:"bytes,1,opt,name=region,proto3" json:"region,omitempty"`
+	Character            string     `protobuf:"bytes,2,opt,name=character,proto3" json:"character,omitempty"`
+	Target               string     `protobuf:"bytes,3,opt,name=target,proto3" json:"target,omitempty"`
+	Kind                 TreatyKind `protobuf:"varint,4,opt,name=kind,proto3,enum=hege.reg.TreatyKind" json:"kind,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *TreatyProposeReq) Reset()         { *m = TreatyProposeReq{} }
+func (m *TreatyProposeReq) String() string { return proto.CompactTextString(m) }
+func (*TreatyProposeReq) ProtoMessage()    {}
+func (*TreatyProposeReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6eef30384a8831dd, []int{71}
+}
+
+func (m *TreatyProposeReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TreatyProposeReq.Unmarshal(m, b)
+}
+func (m *TreatyProposeReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TreatyProposeReq.Marshal(b, m, deterministic)
+}
+func (m *TreatyProposeReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TreatyProposeReq.Merge(m, src)
+}
+func (m *TreatyProposeReq) XXX_Size() int {
+	return xxx_messageInfo_TreatyProposeReq.Size(m)
+}
+func (m *TreatyProposeReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_TreatyProposeReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TreatyProposeReq proto.InternalMessageInfo
+
+func (m *TreatyProposeReq) GetRegion() string {
+	if m != nil {
+		return m.Region
+	}
+	return ""
+}
+
+func (m *TreatyProposeReq) GetCharacter() string {
+	if m != nil {
+		return m.Character
+	}
+	return ""
+}
+
+func (m *TreatyProposeReq) GetTarget() string {
+	if m != nil {
+		return m.Target
+	}
+	return ""
+}
+
+func (m *TreatyProposeReq) GetKind() TreatyKind {
+	if m != nil {
+		return m.Kind
+	}
+	return TreatyKind_NoTreaty
+}
+
+type TreatyReq struct {
+	Region               string   `protobuf:"bytes,1,opt,name=region,proto3" json:"region,omitempty"`
+	Character            string   `protobuf:"bytes,2,opt,name=character,proto3" json:"character,omitempty"`
+	Id                   string   `protobuf:"bytes,3,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TreatyReq) Reset()         { *m = TreatyReq{} }
+func (m *TreatyReq) String() string { return proto.CompactTextString(m) }
+func (*TreatyReq) ProtoMessage()    {}
+func (*TreatyReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6eef30384a8831dd, []int{72}
+}
+
+func (m *TreatyReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TreatyReq.Unmarshal(m, b)
+}
+func (m *TreatyReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TreatyReq.Marshal(b, m, deterministic)
+}
+func (m *TreatyReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TreatyReq.Merge(m, src)
+}
+func (m *TreatyReq) XXX_Size() int {
+	return xxx_messageInfo_TreatyReq.Size(m)
+}
+func (m *TreatyReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_TreatyReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TreatyReq proto.InternalMessageInfo
+
+func (m *TreatyReq) GetRegion() string {
+	if m != nil {
+		return m.Region
+	}
+	return ""
+}
+
+func (m *TreatyReq) GetCharacter() string {
+	if m != nil {
+		return m.Character
+	}
+	return ""
+}
+
+func (m *TreatyReq) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+type OfferView struct {
+	Id                   string        `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	City                 uint64        `protobuf:"varint,2,opt,name=city,proto3" json:"city,omitempty"`
+	Goods                *ResourcesAbs `protobuf:"bytes,3,opt,name=goods,proto3" json:"goods,omitempty"`
+	Price                *ResourcesAbs `protobuf:"bytes,4,opt,name=price,proto3" json:"price,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *OfferView) Reset()         { *m = OfferView{} }
+func (m *OfferView) String() string { return proto.CompactTextString(m) }
+func (*OfferView) ProtoMessage()    {}
+func (*OfferView) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6eef30384a8831dd, []int{73}
+}
+
+func (m *OfferView) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OfferView.Unmarshal(m, b)
+}
+func (m *OfferView) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OfferView.Marshal(b, m, deterministic)
+}
+func (m *OfferView) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OfferView.Merge(m, src)
+}
+func (m *OfferView) XXX_Size() int {
+	return xxx_messageInfo_OfferView.Size(m)
+}
+func (m *OfferView) XXX_DiscardUnknown() {
+	xxx_messageInfo_OfferView.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OfferView proto.InternalMessageInfo
+
+func (m *OfferView) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *OfferView) GetCity() uint64 {
+	if m != nil {
+		return m.City
+	}
+	return 0
+}
+
+func (m *OfferView) GetGoods() *ResourcesAbs {
+	if m != nil {
+		return m.Goods
+	}
+	return nil
+}
+
+func (m *OfferView) GetPrice() *ResourcesAbs {
+	if m != nil {
+		return m.Price
+	}
+	return nil
+}
+
+type MarketListReq struct {
+	Region               string   `protobuf:"bytes,1,opt,name=region,proto3" json:"region,omitempty"`
+	Marker               string   `protobuf:"bytes,2,opt,name=marker,proto3" json:"marker,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MarketListReq) Reset()         { *m = MarketListReq{} }
+func (m *MarketListReq) String() string { return proto.CompactTextString(m) }
+func (*MarketListReq) ProtoMessage()    {}
+func (*MarketListReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6eef30384a8831dd, []int{74}
+}
+
+func (m *MarketListReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MarketListReq.Unmarshal(m, b)
+}
+func (m *MarketListReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MarketListReq.Marshal(b, m, deterministic)
+}
+func (m *MarketListReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MarketListReq.Merge(m, src)
+}
+func (m *MarketListReq) XXX_Size() int {
+	return xxx_messageInfo_MarketListReq.Size(m)
+}
+func (m *MarketListReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_MarketListReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MarketListReq proto.InternalMessageInfo
+
+func (m *MarketListReq) GetRegion() string {
+	if m != nil {
+		return m.Region
+	}
+	return ""
+}
+
+func (m *MarketListReq) GetMarker() string {
+	if m != nil {
+		return m.Marker
+	}
+	return ""
+}
+
+type MarketPostReq struct {
+	City                 *CityId       `protobuf:"bytes,1,opt,name=city,proto3" json:"city,omitempty"`
+	Goods                *ResourcesAbs `protobuf:"bytes,2,opt,name=goods,proto3" json:"goods,omitempty"`
+	Price                *ResourcesAbs `protobuf:"bytes,3,opt,name=price,proto3" json:"price,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *MarketPostReq) Reset()         { *m = MarketPostReq{} }
+func (m *MarketPostReq) String() string { return proto.CompactTextString(m) }
+func (*MarketPostReq) ProtoMessage()    {}
+func (*MarketPostReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6eef30384a8831dd, []int{75}
+}
+
+func (m *MarketPostReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MarketPostReq.Unmarshal(m, b)
+}
+func (m *MarketPostReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MarketPostReq.Marshal(b, m, deterministic)
+}
+func (m *MarketPostReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MarketPostReq.Merge(m, src)
+}
+func (m *MarketPostReq) XXX_Size() int {
+	return xxx_messageInfo_MarketPostReq.Size(m)
+}
+func (m *MarketPostReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_MarketPostReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MarketPostReq proto.InternalMessageInfo
+
+func (m *MarketPostReq) GetCity() *CityId {
+	if m != nil {
+		return m.City
+	}
+	return nil
+}
+
+func (m *MarketPostReq) GetGoods() *ResourcesAbs {
+	if m != nil {
+		return m.Goods
+	}
+	return nil
+}
+
+func (m *MarketPostReq) GetPrice() *ResourcesAbs {
+	if m != nil {
+		return m.Price
+	}
+	return nil
+}
+
+type MarketOfferReq struct {
+	City                 *CityId  `protobuf:"bytes,1,opt,name=city,proto3" json:"city,omitempty"`
+	Offer                string   `protobuf:"bytes,2,opt,name=offer,proto3" json:"offer,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MarketOfferReq) Reset()         { *m = MarketOfferReq{} }
+func (m *MarketOfferReq) String() string { return proto.CompactTextString(m) }
+func (*MarketOfferReq) ProtoMessage()    {}
+func (*MarketOfferReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6eef30384a8831dd, []int{76}
+}
+
+func (m *MarketOfferReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MarketOfferReq.Unmarshal(m, b)
+}
+func (m *MarketOfferReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MarketOfferReq.Marshal(b, m, deterministic)
+}
+func (m *MarketOfferReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MarketOfferReq.Merge(m, src)
+}
+func (m *MarketOfferReq) XXX_Size() int {
+	return xxx_messageInfo_MarketOfferReq.Size(m)
+}
+func (m *MarketOfferReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_MarketOfferReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MarketOfferReq proto.InternalMessageInfo
+
+func (m *MarketOfferReq) GetCity() *CityId {
+	if m != nil {
+		return m.City
+	}
+	return nil
+}
+
+func (m *MarketOfferReq) GetOffer() string {
+	if m != nil {
+		return m.Offer
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterEnum("hege.reg.ArmyCommandType", ArmyCommandType_name, ArmyCommandType_value)
+	proto.RegisterEnum("hege.reg.ClaimStrategy", ClaimStrategy_name, ClaimStrategy_value)
+	proto.RegisterEnum("hege.reg.TreatyKind", TreatyKind_name, TreatyKind_value)
+	proto.RegisterType((*None)(nil), "hege.reg.None")
+	proto.RegisterType((*RegionId)(nil), "hege.reg.RegionId")
+	proto.RegisterType((*RegionCreateReq)(nil), "hege.reg.RegionCreateReq")
+	proto.RegisterType((*ScheduleReq)(nil), "hege.reg.ScheduleReq")
+	proto.RegisterType((*ScheduleView)(nil), "hege.reg.ScheduleView")
+	proto.RegisterType((*NamedItem)(nil), "hege.reg.NamedItem")
+	proto.RegisterType((*ArmyId)(nil), "hege.reg.ArmyId")
+	proto.RegisterType((*ArmyView)(nil), "hege.reg.ArmyView")
+	proto.RegisterType((*ArmyRenameReq)(nil), "hege.reg.ArmyRenameReq")
+	proto.RegisterType((*ArmyArtifactReq)(nil), "hege.reg.ArmyArtifactReq")
+	proto.RegisterType((*ArmyPosture)(nil), "hege.reg.ArmyPosture")
+	proto.RegisterType((*ArmyPostureCityReq)(nil), "hege.reg.ArmyPostureCityReq")
+	proto.RegisterType((*ArmyPostureCharacterReq)(nil), "hege.reg.ArmyPostureCharacterReq")
+	proto.RegisterType((*ArmyMoveReq)(nil), "hege.reg.ArmyMoveReq")
+	proto.RegisterType((*ArmyMoveArgs)(nil), "hege.reg.ArmyMoveArgs")
+	proto.RegisterType((*ArmyAssaultReq)(nil), "hege.reg.ArmyAssaultReq")
+	proto.RegisterType((*ArmyAssaultArgs)(nil), "hege.reg.ArmyAssaultArgs")
+	proto.RegisterType((*ArmyTarget)(nil), "hege.reg.ArmyTarget")
+	proto.RegisterType((*ArmyCommand)(nil), "hege.reg.ArmyCommand")
+	proto.RegisterType((*CityId)(nil), "hege.reg.CityId")
+	proto.RegisterType((*ResourcesAbs)(nil), "hege.reg.ResourcesAbs")
+	proto.RegisterType((*ResourcesPlus)(nil), "hege.reg.ResourcesPlus")
+	proto.RegisterType((*ResourcesMult)(nil), "hege.reg.ResourcesMult")
+	proto.RegisterType((*ResourcesMod)(nil), "hege.reg.ResourcesMod")
+	proto.RegisterType((*UnitTypeView)(nil), "hege.reg.UnitTypeView")
+	proto.RegisterType((*UnitTypeBonus)(nil), "hege.reg.UnitTypeBonus")
+	proto.RegisterType((*BuildingTypeView)(nil), "hege.reg.BuildingTypeView")
+	proto.RegisterType((*KnowledgeTypeView)(nil), "hege.reg.KnowledgeTypeView")
+	proto.RegisterType((*ArtifactTypeView)(nil), "hege.reg.ArtifactTypeView")
+	proto.RegisterType((*UnitView)(nil), "hege.reg.UnitView")
+	proto.RegisterType((*BuildingView)(nil), "hege.reg.BuildingView")
+	proto.RegisterType((*KnowledgeView)(nil), "hege.reg.KnowledgeView")
+	proto.RegisterType((*StockView)(nil), "hege.reg.StockView")
+	proto.RegisterType((*ProductionView)(nil), "hege.reg.ProductionView")
+	proto.RegisterType((*CityEvolution)(nil), "hege.reg.CityEvolution")
+	proto.RegisterType((*CityAssets)(nil), "hege.reg.CityAssets")
+	proto.RegisterType((*CityPolitics)(nil), "hege.reg.CityPolitics")
+	proto.RegisterType((*PublicCity)(nil), "hege.reg.PublicCity")
+	proto.RegisterType((*CityView)(nil), "hege.reg.CityView")
+	proto.RegisterType((*QueueBudget)(nil), "hege.reg.QueueBudget")
+	proto.RegisterType((*ProductionQueueView)(nil), "hege.reg.ProductionQueueView")
+	proto.RegisterType((*QueueOrderReq)(nil), "hege.reg.QueueOrderReq")
+	proto.RegisterType((*PauseItemReq)(nil), "hege.reg.PauseItemReq")
+	proto.RegisterType((*CancelReq)(nil), "hege.reg.CancelReq")
+	proto.RegisterType((*CityClaimReq)(nil), "hege.reg.CityClaimReq")
+	proto.RegisterType((*QueueBudgetReq)(nil), "hege.reg.QueueBudgetReq")
+	proto.RegisterType((*StudyReq)(nil), "hege.reg.StudyReq")
+	proto.RegisterType((*TrainReq)(nil), "hege.reg.TrainReq")
+	proto.RegisterType((*BuildReq)(nil), "hege.reg.BuildReq")
+	proto.RegisterType((*CreateTransportReq)(nil), "hege.reg.CreateTransportReq")
+	proto.RegisterType((*CreateArmyReq)(nil), "hege.reg.CreateArmyReq")
+	proto.RegisterType((*TransferUnitReq)(nil), "hege.reg.TransferUnitReq")
+	proto.RegisterType((*TransferResourcesReq)(nil), "hege.reg.TransferResourcesReq")
+	proto.RegisterType((*TransferArtifactReq)(nil), "hege.reg.TransferArtifactReq")
+	proto.RegisterType((*ArtifactCreateReq)(nil), "hege.reg.ArtifactCreateReq")
+	proto.RegisterType((*CityDeputyReq)(nil), "hege.reg.CityDeputyReq")
+	proto.RegisterType((*CityTransferReq)(nil), "hege.reg.CityTransferReq")
+	proto.RegisterType((*CityRenameReq)(nil), "hege.reg.CityRenameReq")
+	proto.RegisterType((*DismantleReq)(nil), "hege.reg.DismantleReq")
+	proto.RegisterType((*DisbandUnitsReq)(nil), "hege.reg.DisbandUnitsReq")
+	proto.RegisterType((*CityAutoReq)(nil), "hege.reg.CityAutoReq")
+	proto.RegisterType((*SpyReq)(nil), "hege.reg.SpyReq")
+	proto.RegisterType((*SpyStealReq)(nil), "hege.reg.SpyStealReq")
+	proto.RegisterType((*SpyReport)(nil), "hege.reg.SpyReport")
+	proto.RegisterType((*EpidemicSeedReq)(nil), "hege.reg.EpidemicSeedReq")
+	proto.RegisterType((*CitiesByCharReq)(nil), "hege.reg.CitiesByCharReq")
+	proto.RegisterType((*PaginatedQuery)(nil), "hege.reg.PaginatedQuery")
+	proto.RegisterType((*Artifact)(nil), "hege.reg.Artifact")
+	proto.RegisterType((*PopularityChange)(nil), "hege.reg.PopularityChange")
+	proto.RegisterType((*TreatyView)(nil), "hege.reg.TreatyView")
+	proto.RegisterType((*TreatyListReq)(nil), "hege.reg.TreatyListReq")
+	proto.RegisterType((*TreatyProposeReq)(nil), "hege.reg.TreatyProposeReq")
+	proto.RegisterType((*TreatyReq)(nil), "hege.reg.TreatyReq")
+	proto.RegisterType((*OfferView)(nil), "hege.reg.OfferView")
+	proto.RegisterType((*MarketListReq)(nil), "hege.reg.MarketListReq")
+	proto.RegisterType((*MarketPostReq)(nil), "hege.reg.MarketPostReq")
+	proto.RegisterType((*MarketOfferReq)(nil), "hege.reg.MarketOfferReq")
+}
+
+func init() { proto.RegisterFile("region.proto", fileDescriptor_6eef30384a8831dd) }
+
+var fileDescriptor_6eef30384a8831dd = []byte{
+	// 3639 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x3b, 0x5d, 0x6f, 0xdc, 0xc6,
+	0xb5, 0xe2, 0xc7, 0xae, 0x76, 0xcf, 0xee, 0x4a, 0xeb, 0xf1, 0x17, 0xa3, 0x38, 0x81, 0x2e, 0x11,
+	0x5c, 0xf8, 0xfa, 0xda, 0xb2, 0x2d, 0x3b, 0x89, 0x7d, 0x7d, 0x83, 0x44, 0x92, 0xed, 0xc0, 0xb1,
+	0x65, 0x2b, 0x94, 0x92, 0x14, 0x05, 0x8a, 0x86, 0x22, 0x47, 0x2b, 0x56, 0x5c, 0x72, 0xcd, 0x0f,
+	0xb9, 0x02, 0x8a, 0xbe, 0xf4, 0xad, 0x45, 0x81, 0xb6, 0x28, 0xd0, 0x9f, 0xd0, 0x1f, 0x90, 0x97,
+	0xb6, 0x0f, 0x45, 0x9f, 0xfa, 0x33, 0xfa, 0xd8, 0xbe, 0xf4, 0x29, 0xbf, 0xa0, 0x38, 0x33, 0x43,
+	0x72, 0xc8, 0xe5, 0xae, 0x28, 0x3b, 0x7d, 0xea, 0x93, 0x75, 0x86, 0xe7, 0x63, 0xce, 0x99, 0x33,
+	0xe7, 0x6b, 0xd6, 0xd0, 0x8f, 0xe8, 0xc8, 0x0b, 0x83, 0xb5, 0x49, 0x14, 0x26, 0x21, 0xe9, 0x1c,
+	0xd2, 0x11, 0x5d, 0x8b, 0xe8, 0xc8, 0x6c, 0x83, 0xfe, 0x3c, 0x0c, 0xa8, 0x69, 0x42, 0xc7, 0x62,
+	0x18, 0x4f, 0x5c, 0x72, 0x09, 0xda, 0x1c, 0xdb, 0x50, 0x56, 0x95, 0xab, 0x5d, 0x4b, 0x40, 0xe6,
+	0xc7, 0xb0, 0xcc, 0x71, 0xb6, 0x22, 0x6a, 0x27, 0xd4, 0xa2, 0x2f, 0x09, 0x01, 0x3d, 0xb0, 0xc7,
+	0x54, 0x20, 0xb2, 0xbf, 0x89, 0x01, 0x8b, 0x63, 0x7b, 0xf2, 0x1c, 0x97, 0x55, 0xb6, 0x9c, 0x81,
+	0xe6, 0x11, 0xf4, 0x76, 0x9d, 0x43, 0xea, 0xa6, 0x3e, 0x23, 0x9e, 0x21, 0x87, 0xbc, 0x0b, 0x30,
+	0x0e, 0x8f, 0xe9, 0x0e, 0x8d, 0xbc, 0xd0, 0x65, 0x3c, 0x06, 0x96, 0xb4, 0x42, 0xde, 0x83, 0xc1,
+	0x24, 0x0a, 0xdd, 0xd4, 0xc9, 0x50, 0x34, 0x86, 0x52, 0x5e, 0x34, 0xff, 0xa9, 0x40, 0x3f, 0x93,
+	0xf6, 0xa5, 0x47, 0x5f, 0x55, 0xd8, 0x2a, 0xa7, 0xb3, 0x55, 0x6b, 0xd8, 0xe2, 0xa6, 0x27, 0x76,
+	0x1a, 0x53, 0x2e, 0xb5, 0x63, 0x09, 0x88, 0x5c, 0x81, 0x2e, 0xf2, 0xda, 0xf3, 0x9c, 0xa3, 0xd8,
+	0xd0, 0x57, 0x95, 0xab, 0xba, 0x55, 0x2c, 0x10, 0x13, 0xfa, 0x82, 0x0d, 0x47, 0x68, 0x31, 0x84,
+	0xd2, 0x1a, 0x59, 0x81, 0x8e, 0x6f, 0xc7, 0xc9, 0x76, 0x78, 0x4c, 0x8d, 0xf6, 0xaa, 0x72, 0x55,
+	0xb3, 0x72, 0x98, 0xac, 0x42, 0x0f, 0xff, 0xde, 0xe1, 0xf8, 0xc6, 0x22, 0xfb, 0x2c, 0x2f, 0x99,
+	0x37, 0xa1, 0x8b, 0x36, 0x76, 0x9f, 0x24, 0x74, 0x4c, 0x96, 0x40, 0xf5, 0xb8, 0x8a, 0xba, 0xa5,
+	0x7a, 0x6e, 0x7e, 0x4c, 0x6a, 0x71, 0x4c, 0xe6, 0x01, 0xb4, 0x37, 0xa2, 0xf1, 0xc9, 0xec, 0xf3,
+	0x46, 0x95, 0x9c, 0x43, 0x3b, 0xb2, 0x9d, 0x84, 0x46, 0x82, 0xb4, 0x58, 0x40, 0x9e, 0x8e, 0x97,
+	0x9c, 0x30, 0x33, 0xe8, 0x16, 0xfb, 0x1b, 0xd7, 0xec, 0x68, 0x7c, 0x22, 0xf4, 0x67, 0x7f, 0x9b,
+	0x7f, 0x53, 0xa1, 0x83, 0x82, 0xd8, 0x19, 0x34, 0xd8, 0x18, 0xb3, 0x43, 0xe8, 0xd8, 0x09, 0x6e,
+	0x88, 0x33, 0xcf, 0x61, 0x72, 0x1d, 0x5a, 0x71, 0x12, 0x3a, 0x47, 0x4c, 0x42, 0x6f, 0xfd, 0xd2,
+	0x5a, 0xe6, 0xc8, 0x6b, 0x16, 0x8d, 0xc3, 0x34, 0x72, 0x68, 0xbc, 0xb1, 0x1f, 0x5b, 0x1c, 0x89,
+	0x5c, 0x85, 0x56, 0x1a, 0x78, 0x09, 0x9a, 0x5b, 0xbb, 0xda, 0x5b, 0x27, 0x05, 0xf6, 0x17, 0x81,
+	0x97, 0xe0, 0x86, 0x2c, 0x8e, 0x40, 0x6e, 0x43, 0xc7, 0x09, 0xc7, 0x63, 0x3b, 0x70, 0x63, 0xa3,
+	0xcd, 0x90, 0x2f, 0x16, 0xc8, 0xb8, 0xfb, 0x2d, 0xfe, 0xd5, 0xca, 0xd1, 0x90, 0x64, 0x12, 0xc6,
+	0x49, 0x1a, 0xd1, 0xd8, 0x58, 0xac, 0x23, 0xd9, 0xe1, 0x5f, 0xad, 0x1c, 0x8d, 0xdc, 0x82, 0xae,
+	0x1d, 0x25, 0xde, 0x81, 0xed, 0x24, 0xb1, 0xd1, 0xa9, 0xee, 0x69, 0x43, 0x7c, 0xb2, 0x0a, 0x24,
+	0xf4, 0x9b, 0xc4, 0x73, 0x8e, 0x1e, 0x4d, 0x3c, 0x97, 0x8e, 0x3d, 0xc7, 0xe8, 0x32, 0x97, 0x2c,
+	0xad, 0x99, 0x8f, 0x60, 0x80, 0xe2, 0x2c, 0x8a, 0xd6, 0xc3, 0x7b, 0xb5, 0x9a, 0x1b, 0xb9, 0xb7,
+	0x3e, 0x2c, 0xef, 0xe9, 0x89, 0x3b, 0xd3, 0x1f, 0x5e, 0xc0, 0x32, 0x62, 0xe4, 0xbb, 0x68, 0xc4,
+	0x68, 0x05, 0x3a, 0xd9, 0x66, 0x05, 0xb3, 0x1c, 0x36, 0xbf, 0x80, 0x9e, 0x64, 0x86, 0xdc, 0x5f,
+	0x14, 0xc9, 0x5f, 0xe6, 0x7b, 0xd8, 0x05, 0x68, 0x1d, 0xdb, 0x7e, 0x4a, 0x99, 0x17, 0x68, 0x16,
+	0x07, 0xcc, 0xaf, 0x81, 0x48, 0x6c, 0xb7, 0xbc, 0xe4, 0xa4, 0xb1, 0xce, 0x4c, 0xbe, 0x2a, 0xc9,
+	0xaf, 0x97, 0x10, 0xc2, 0x65, 0x59, 0x42, 0xb6, 0x9f, 0x66, 0x62, 0x5e, 0x47, 0xa5, 0x98, 0x5b,
+	0x0a, 0x6f, 0x7a, 0x33, 0x21, 0x97, 0xa0, 0x9d, 0xd8, 0xd1, 0x88, 0x26, 0x42, 0x1b, 0x01, 0x91,
+	0x6b, 0x78, 0xff, 0x46, 0xb1, 0xa1, 0x55, 0x6f, 0x47, 0xc6, 0x7e, 0x23, 0x1a, 0xc5, 0x16, 0xc3,
+	0x31, 0x7f, 0x02, 0x7d, 0x79, 0xb5, 0xb8, 0x5a, 0x4a, 0x93, 0xab, 0x75, 0x45, 0x76, 0x65, 0x7d,
+	0x55, 0x43, 0x35, 0xf3, 0x05, 0x54, 0xb3, 0xb8, 0x78, 0x5d, 0x71, 0xc9, 0x3e, 0xd3, 0x3b, 0xea,
+	0x50, 0xfb, 0x4c, 0xef, 0x68, 0x43, 0xdd, 0x3c, 0x81, 0x25, 0xe6, 0x6d, 0x71, 0x6c, 0xa7, 0x7e,
+	0xf2, 0x66, 0x5a, 0xdf, 0x28, 0x69, 0xfd, 0x56, 0x99, 0x56, 0x48, 0x90, 0x14, 0xff, 0x21, 0x2c,
+	0x57, 0x3e, 0xa0, 0x1b, 0x8f, 0xed, 0x38, 0xb6, 0x9d, 0x88, 0xa7, 0xb2, 0x8e, 0x95, 0xc3, 0xf8,
+	0x2d, 0x3c, 0xa6, 0x91, 0x1f, 0x46, 0x3c, 0x23, 0x74, 0xac, 0x1c, 0x46, 0x3d, 0xf7, 0x23, 0x6a,
+	0x1f, 0x89, 0x5c, 0xc0, 0x01, 0xf3, 0x31, 0x00, 0x0a, 0xd8, 0xe3, 0xbb, 0x7b, 0x6d, 0xbd, 0xcc,
+	0x6f, 0x14, 0xe8, 0x49, 0xb1, 0x47, 0xc2, 0x53, 0xaa, 0xfa, 0x27, 0x27, 0x13, 0x7e, 0x9b, 0x97,
+	0xaa, 0xfa, 0x0b, 0xe2, 0xbd, 0x93, 0x09, 0xb5, 0x18, 0x1a, 0x3a, 0x09, 0x26, 0xa6, 0xd3, 0x9c,
+	0x04, 0x71, 0xc8, 0x6d, 0x68, 0xdb, 0x49, 0x62, 0xe7, 0x01, 0x77, 0x8e, 0x71, 0x05, 0xa2, 0x69,
+	0x41, 0x1b, 0x2f, 0xe5, 0x77, 0x99, 0x57, 0xcc, 0x00, 0xfa, 0xb2, 0x13, 0x62, 0x1a, 0x89, 0x6e,
+	0x65, 0x69, 0x24, 0xba, 0xc5, 0xe0, 0xdb, 0xc2, 0x7a, 0x6a, 0x74, 0x9b, 0xc1, 0xeb, 0x82, 0x83,
+	0x1a, 0xad, 0x33, 0xf8, 0x8e, 0xc8, 0x4a, 0x6a, 0x74, 0x87, 0xc1, 0x77, 0x45, 0x12, 0x56, 0xa3,
+	0xbb, 0x0c, 0x7e, 0xdf, 0x68, 0x0b, 0xf8, 0x7d, 0x33, 0x84, 0x41, 0x2e, 0x6f, 0xc7, 0x4f, 0x65,
+	0x81, 0x5a, 0x45, 0xa0, 0x56, 0x11, 0xa8, 0x55, 0x04, 0x6a, 0x15, 0x81, 0x5a, 0x45, 0xa0, 0x36,
+	0x25, 0x70, 0x3b, 0xf5, 0x13, 0x49, 0xa0, 0x52, 0x11, 0xa8, 0x54, 0x04, 0x2a, 0x15, 0x81, 0x4a,
+	0x45, 0xa0, 0x52, 0x11, 0xa8, 0x30, 0x81, 0x87, 0x92, 0x45, 0xb7, 0x43, 0x97, 0xfc, 0x2f, 0xe8,
+	0x13, 0x3f, 0x8d, 0x85, 0x9f, 0x5e, 0xae, 0xb9, 0xfc, 0x68, 0x07, 0x8b, 0x21, 0x21, 0xf2, 0x38,
+	0xf5, 0xb9, 0xbb, 0xd6, 0x23, 0xa3, 0x0e, 0x16, 0x43, 0x32, 0x7f, 0xaf, 0x42, 0x1f, 0xd3, 0x2d,
+	0x7a, 0x60, 0xe3, 0x1a, 0xe0, 0x02, 0xb4, 0x12, 0x56, 0x28, 0xf1, 0xd2, 0x8e, 0x03, 0xe8, 0x50,
+	0x87, 0xd4, 0xf6, 0x93, 0x43, 0xa6, 0xe8, 0xc0, 0x12, 0x10, 0x66, 0x49, 0xfe, 0xd7, 0x63, 0xdb,
+	0x49, 0xc2, 0x48, 0xa8, 0x5d, 0x5a, 0x43, 0x5a, 0xe1, 0xc9, 0x6d, 0x4e, 0xcb, 0x21, 0xac, 0x56,
+	0x5d, 0x7a, 0x40, 0x83, 0x98, 0x57, 0x55, 0x03, 0x2b, 0x03, 0x71, 0x0f, 0x76, 0x34, 0x0e, 0x23,
+	0xa3, 0xc3, 0xf7, 0xc0, 0x00, 0x5c, 0x8d, 0x27, 0x94, 0xba, 0x22, 0x15, 0x73, 0x00, 0x57, 0x1d,
+	0x3b, 0x8a, 0x4e, 0x0c, 0x60, 0x6a, 0x71, 0x80, 0xdc, 0x80, 0xd6, 0x7e, 0x18, 0xa4, 0xb1, 0xd1,
+	0x5b, 0xd5, 0xca, 0x86, 0xca, 0x0c, 0xb2, 0x89, 0x9f, 0x2d, 0x8e, 0x65, 0x3e, 0x80, 0x41, 0x69,
+	0x1d, 0xf7, 0xec, 0xb1, 0x9b, 0x9b, 0x5d, 0x78, 0x0e, 0xa1, 0xc5, 0x72, 0xfb, 0x2b, 0xc2, 0xcc,
+	0xcf, 0x60, 0xb8, 0x99, 0x7a, 0xbe, 0xeb, 0x05, 0xa3, 0x37, 0xb7, 0xb4, 0xb9, 0x0d, 0xe7, 0x9e,
+	0x06, 0xe1, 0x2b, 0x9f, 0xba, 0x23, 0xfa, 0x1d, 0xb0, 0xfb, 0xab, 0x02, 0xc3, 0xac, 0xb0, 0x38,
+	0x13, 0x3b, 0x03, 0x16, 0x8f, 0xbd, 0xd8, 0xdb, 0xf7, 0xa9, 0x08, 0xb1, 0x19, 0x88, 0x61, 0x79,
+	0x12, 0x4e, 0x98, 0x9d, 0xc4, 0x3d, 0xcb, 0xe1, 0x22, 0x95, 0xb5, 0x66, 0xa6, 0xb2, 0xed, 0xd0,
+	0xcd, 0x52, 0xd9, 0x35, 0xd0, 0xb1, 0x0e, 0x37, 0xda, 0x73, 0x91, 0x19, 0x8e, 0xf9, 0x67, 0x05,
+	0x3a, 0x59, 0xed, 0x88, 0x84, 0x49, 0x76, 0x38, 0x25, 0x42, 0xd9, 0xdd, 0x45, 0xd0, 0xe5, 0xca,
+	0x72, 0xd5, 0x44, 0xcc, 0x17, 0x47, 0xab, 0x95, 0x8e, 0x36, 0xb7, 0x9f, 0x5e, 0xef, 0xf8, 0xad,
+	0x92, 0xe3, 0x67, 0x26, 0x6b, 0x4b, 0x26, 0xbb, 0x02, 0x5d, 0xfe, 0x75, 0xdb, 0xfe, 0xb1, 0x70,
+	0xe9, 0x62, 0xc1, 0xfc, 0x95, 0x02, 0xfd, 0xcc, 0x4f, 0x98, 0x12, 0x6b, 0x25, 0x25, 0x56, 0x0a,
+	0x25, 0xaa, 0xde, 0xf4, 0x9d, 0x28, 0x92, 0x6d, 0xb8, 0x25, 0x15, 0x9e, 0xbf, 0x51, 0x60, 0x90,
+	0x3b, 0x1b, 0xdb, 0xd3, 0xcd, 0xd2, 0x9e, 0xde, 0x2e, 0xf6, 0x34, 0xe5, 0x93, 0xff, 0xb6, 0x4d,
+	0xfd, 0x43, 0x85, 0xee, 0x2e, 0xba, 0x47, 0x76, 0xd2, 0xfb, 0x76, 0x4c, 0x4f, 0x29, 0x8d, 0x18,
+	0x0e, 0xb9, 0x0b, 0xdd, 0xa3, 0x6c, 0x9b, 0x86, 0x3a, 0x93, 0x00, 0x7d, 0xaa, 0x40, 0x44, 0xaa,
+	0x7d, 0x61, 0xf0, 0x9a, 0xf2, 0xad, 0x4c, 0x95, 0x23, 0x92, 0x35, 0x68, 0x27, 0x51, 0x18, 0x4e,
+	0x62, 0x43, 0x9f, 0x4b, 0x22, 0xb0, 0x10, 0xdf, 0x76, 0x92, 0xd4, 0xf6, 0x8d, 0xd6, 0x5c, 0x4d,
+	0x04, 0x16, 0x5e, 0xa4, 0x34, 0xb6, 0x47, 0xd4, 0x68, 0xcf, 0x45, 0xe7, 0x48, 0xa8, 0x43, 0x51,
+	0x13, 0x2e, 0xce, 0xd7, 0x21, 0x47, 0x34, 0xbf, 0x55, 0x61, 0x89, 0x37, 0xb1, 0xd8, 0xe1, 0xfd,
+	0x47, 0x9b, 0xbb, 0x64, 0xc0, 0x76, 0x43, 0x03, 0x92, 0x75, 0xe8, 0xc4, 0x87, 0x61, 0x94, 0xe0,
+	0x39, 0x2d, 0xce, 0x95, 0x93, 0xe3, 0x99, 0x7f, 0x52, 0x60, 0x80, 0x55, 0xda, 0xa3, 0xe3, 0xd0,
+	0x4f, 0x59, 0x67, 0x7d, 0x1f, 0xba, 0x47, 0x8f, 0xa3, 0x30, 0x48, 0x3c, 0x1a, 0x19, 0xca, 0xaa,
+	0x76, 0xda, 0xc5, 0x2b, 0xb0, 0xc9, 0x3d, 0xe8, 0xee, 0xe7, 0xa4, 0xea, 0xaa, 0x76, 0x4a, 0x1c,
+	0x29, 0x90, 0x51, 0xe1, 0x34, 0xa7, 0xd4, 0x56, 0xb5, 0xf2, 0xde, 0x4b, 0x61, 0xb4, 0x40, 0x34,
+	0x7f, 0xa6, 0x02, 0xe0, 0xe6, 0x37, 0xe2, 0x98, 0x26, 0x71, 0xd1, 0xe5, 0x2b, 0xa7, 0x75, 0xf9,
+	0xa5, 0x53, 0x57, 0xab, 0xe2, 0xe4, 0xb0, 0x28, 0x9f, 0xfa, 0x87, 0x00, 0xb9, 0xe3, 0xc4, 0x86,
+	0x56, 0x4d, 0xe5, 0xa5, 0xd0, 0x65, 0x49, 0xa8, 0xe4, 0x1a, 0xb4, 0xed, 0x68, 0xec, 0x51, 0xde,
+	0x20, 0x55, 0x7a, 0x7d, 0x3e, 0x10, 0xb1, 0x04, 0x46, 0x79, 0x34, 0xd0, 0x6a, 0x30, 0x1a, 0x30,
+	0x37, 0xa1, 0x8f, 0x46, 0xd8, 0x09, 0x7d, 0x2f, 0xf1, 0x9c, 0xb8, 0xd4, 0xa7, 0xf0, 0xa4, 0x9a,
+	0xc3, 0x18, 0x0f, 0x7d, 0x8f, 0x8e, 0x28, 0xd7, 0x5a, 0xb7, 0x04, 0x64, 0x7e, 0xab, 0x00, 0xec,
+	0xa4, 0xfb, 0xbe, 0xe7, 0x20, 0xab, 0x46, 0x19, 0x19, 0x1b, 0x3f, 0xdf, 0x1b, 0x05, 0x63, 0x1a,
+	0x24, 0xec, 0x1a, 0xb4, 0xac, 0x62, 0x81, 0xd5, 0x41, 0x87, 0x76, 0xc8, 0x07, 0x5c, 0x2d, 0x8b,
+	0x03, 0x3c, 0x57, 0xf3, 0x6d, 0x8a, 0xea, 0x2b, 0x87, 0x51, 0x86, 0x83, 0xb5, 0x0c, 0xcf, 0x54,
+	0xec, 0x6f, 0xe4, 0x42, 0x93, 0xc3, 0xe0, 0x24, 0xab, 0xbc, 0x18, 0x80, 0xab, 0xb1, 0x13, 0x46,
+	0x94, 0x55, 0x5e, 0x9a, 0xc5, 0x81, 0xb2, 0xe1, 0xa0, 0x89, 0xe1, 0xfe, 0xa8, 0x43, 0x07, 0xd5,
+	0x65, 0xa1, 0xe6, 0x3a, 0xb4, 0x27, 0xcc, 0x00, 0x22, 0xd8, 0x5c, 0x28, 0x68, 0x0b, 0xc3, 0x58,
+	0x02, 0x07, 0xb7, 0x10, 0xbe, 0x0a, 0x98, 0xaf, 0xa2, 0x45, 0x38, 0x80, 0xd6, 0x75, 0xe9, 0x24,
+	0x4d, 0xf8, 0xdc, 0xab, 0x6b, 0x09, 0x08, 0x07, 0x8a, 0x98, 0x60, 0xb6, 0x45, 0x27, 0x19, 0x1b,
+	0x7d, 0x3e, 0x50, 0x2c, 0x2d, 0xb2, 0x99, 0x59, 0x9a, 0x84, 0xc6, 0x80, 0xd5, 0x37, 0xec, 0x6f,
+	0xbc, 0xd2, 0xb9, 0xc1, 0x96, 0xab, 0x57, 0x5a, 0x3e, 0x75, 0xc9, 0x90, 0xff, 0x93, 0x15, 0x3d,
+	0x43, 0x46, 0x70, 0xbe, 0x20, 0xc8, 0xf3, 0x58, 0x56, 0xf1, 0xdc, 0x03, 0x98, 0xe4, 0x11, 0xd7,
+	0x38, 0xc7, 0xf0, 0x0d, 0x49, 0xf1, 0x52, 0x34, 0xb6, 0x24, 0x5c, 0x34, 0x97, 0xcd, 0x6e, 0x9d,
+	0x41, 0xaa, 0xe6, 0x2a, 0x6e, 0xa4, 0x25, 0x70, 0xb0, 0x4f, 0xa0, 0xc7, 0xa1, 0x6f, 0x9c, 0xaf,
+	0xf6, 0x09, 0xa5, 0xd0, 0x63, 0x31, 0xa4, 0xa9, 0x51, 0xd7, 0x85, 0xe9, 0x51, 0x97, 0x54, 0x07,
+	0x5d, 0x64, 0x3e, 0x20, 0x20, 0x72, 0x07, 0x5a, 0x2f, 0x53, 0x9a, 0x52, 0xe3, 0x12, 0x93, 0xf4,
+	0x4e, 0x9d, 0x2e, 0x9f, 0x23, 0x02, 0xb7, 0x02, 0xc3, 0x65, 0x71, 0x53, 0xcc, 0x87, 0x8d, 0xcb,
+	0x55, 0x23, 0xcb, 0x93, 0x63, 0x2b, 0xc7, 0x33, 0x6d, 0xe8, 0x31, 0x3e, 0x9b, 0xa9, 0x8b, 0x9d,
+	0xf7, 0x85, 0x22, 0xf4, 0x60, 0x25, 0xce, 0x01, 0xbc, 0x22, 0x72, 0x98, 0xc1, 0x2f, 0xc5, 0x02,
+	0x8e, 0xa1, 0x4b, 0xe1, 0x04, 0x3f, 0x4b, 0x2b, 0x66, 0x04, 0xe7, 0x6b, 0x36, 0xcd, 0x5c, 0x2f,
+	0x72, 0x45, 0x6c, 0xee, 0x5a, 0x1c, 0x90, 0xa6, 0xd1, 0x2a, 0x5b, 0x16, 0x10, 0xb9, 0x01, 0xed,
+	0x7d, 0xb6, 0x45, 0x91, 0xdc, 0xa4, 0xd1, 0xa4, 0xb4, 0x7f, 0x4b, 0x20, 0x99, 0x4f, 0x60, 0xc0,
+	0x96, 0x5f, 0x20, 0x53, 0x1c, 0xc6, 0xbc, 0x27, 0x0d, 0xeb, 0x4a, 0x63, 0x0b, 0xde, 0xda, 0x17,
+	0xe3, 0x5e, 0x2f, 0xa1, 0x63, 0x21, 0x9b, 0xfd, 0x6d, 0x7e, 0x0d, 0xfd, 0x1d, 0xdc, 0x03, 0xce,
+	0xa1, 0x5f, 0x87, 0x93, 0x92, 0x71, 0x9a, 0x35, 0x69, 0x37, 0x1f, 0x41, 0x77, 0xcb, 0x0e, 0x1c,
+	0xea, 0xbf, 0x11, 0x7b, 0xf3, 0x97, 0x0a, 0x0f, 0xa0, 0x5b, 0xbe, 0xed, 0x8d, 0xe7, 0x3d, 0x47,
+	0xcc, 0x1f, 0x57, 0xdc, 0x81, 0x4e, 0x9c, 0x44, 0x76, 0x42, 0x47, 0x7c, 0x64, 0xb1, 0x54, 0xf2,
+	0x73, 0xe4, 0xbd, 0x2b, 0x3e, 0x5b, 0x39, 0x22, 0x0b, 0x7a, 0xd4, 0xf7, 0xb3, 0x39, 0x39, 0xfe,
+	0x6d, 0x52, 0x58, 0x92, 0x8f, 0xa6, 0xb1, 0x6e, 0xc5, 0x51, 0xab, 0x4d, 0x8e, 0xfa, 0x4b, 0xe8,
+	0xec, 0x26, 0xa9, 0x7b, 0xd2, 0x5c, 0xc0, 0x7b, 0x30, 0x38, 0x92, 0xd3, 0xbf, 0x98, 0xb3, 0x94,
+	0x17, 0xcd, 0x67, 0xd0, 0xd9, 0x8b, 0x6c, 0x2f, 0x68, 0xce, 0x77, 0x05, 0x3a, 0xa9, 0xc8, 0xf0,
+	0x82, 0x65, 0x0e, 0x9b, 0x7b, 0xd0, 0x61, 0xe9, 0xb8, 0x39, 0x37, 0x13, 0xfa, 0xfb, 0x52, 0xa5,
+	0x21, 0x38, 0x96, 0xd6, 0xcc, 0xdf, 0x2a, 0x40, 0xf8, 0xdb, 0xd5, 0x5e, 0x64, 0x07, 0xf1, 0x24,
+	0x8c, 0x92, 0x33, 0xf9, 0xd0, 0x54, 0x32, 0x2c, 0x26, 0x72, 0x5a, 0x69, 0x22, 0x77, 0xa6, 0x67,
+	0x0a, 0xf3, 0x07, 0x30, 0xe0, 0xbb, 0xe2, 0x63, 0xfc, 0x37, 0xd9, 0x10, 0x01, 0x1d, 0x6d, 0xc8,
+	0xaa, 0x14, 0xdd, 0x62, 0x7f, 0xe3, 0xbc, 0x93, 0xa9, 0x7b, 0x40, 0x23, 0x2c, 0x88, 0xce, 0x24,
+	0x80, 0xbd, 0xe6, 0xf0, 0x64, 0xc7, 0xfe, 0xce, 0x05, 0xe8, 0x92, 0x80, 0x9f, 0xc2, 0x85, 0x4c,
+	0x40, 0xae, 0xde, 0x9b, 0x49, 0x39, 0x9b, 0xfd, 0x8e, 0xe0, 0x7c, 0x26, 0x5f, 0x7e, 0xbd, 0x38,
+	0x9b, 0x78, 0x55, 0x12, 0x2f, 0xbf, 0x6a, 0x68, 0x95, 0x57, 0x8d, 0x11, 0x9c, 0xcb, 0x84, 0x14,
+	0xcf, 0xa0, 0xb3, 0x42, 0x47, 0xdd, 0x9b, 0x03, 0x11, 0xcd, 0xad, 0x98, 0x6f, 0x26, 0x62, 0xa0,
+	0xc3, 0x8e, 0x52, 0x97, 0x3a, 0xd0, 0x6d, 0x5e, 0xa1, 0x3f, 0x64, 0xb5, 0x44, 0x73, 0x7d, 0x8a,
+	0x62, 0x44, 0x95, 0x8b, 0x11, 0x73, 0x1b, 0x96, 0x11, 0xaf, 0x38, 0xa8, 0xa6, 0x0c, 0xf3, 0x9a,
+	0x47, 0x95, 0x6a, 0x1e, 0xcc, 0x18, 0xfc, 0xe9, 0x25, 0x7b, 0x74, 0x7a, 0x6d, 0x9f, 0x35, 0x77,
+	0xa0, 0xff, 0xd0, 0x8b, 0xc7, 0x76, 0x90, 0xf8, 0xf4, 0x4c, 0xd1, 0x23, 0xbb, 0xdb, 0xd9, 0xcb,
+	0x53, 0x06, 0x9b, 0x4f, 0x61, 0xf9, 0xa1, 0x17, 0xef, 0xdb, 0x81, 0x8b, 0x0e, 0x7f, 0x36, 0x5f,
+	0x64, 0xde, 0x2d, 0x12, 0x1a, 0xf3, 0xee, 0x4f, 0xa1, 0xc7, 0x4a, 0x9b, 0x34, 0x09, 0xcf, 0xe6,
+	0x55, 0x58, 0xd4, 0xa9, 0x45, 0x51, 0x67, 0x3e, 0x86, 0xf6, 0xee, 0xe4, 0x6c, 0x27, 0x59, 0xfb,
+	0x2c, 0xf0, 0x12, 0x7a, 0xbb, 0x93, 0x93, 0xdd, 0x84, 0xda, 0xfe, 0x1b, 0x33, 0x9b, 0x0e, 0xee,
+	0x5a, 0x5d, 0x70, 0xdf, 0x86, 0x2e, 0xdb, 0x3a, 0x46, 0x4c, 0x9c, 0xc9, 0xc5, 0xa9, 0xe3, 0xd0,
+	0x38, 0x16, 0x6f, 0x25, 0x19, 0x48, 0xfe, 0x1b, 0xf4, 0x63, 0x8f, 0xbe, 0x12, 0x89, 0x88, 0x94,
+	0xb7, 0xc2, 0xc7, 0x35, 0xf8, 0xdd, 0xfc, 0x08, 0x96, 0xb3, 0x92, 0x6e, 0x97, 0x52, 0xf7, 0x8c,
+	0x37, 0x08, 0x03, 0xda, 0x96, 0x97, 0x78, 0x34, 0xde, 0x3c, 0xc1, 0xc7, 0xb9, 0xd7, 0xcf, 0xdd,
+	0x97, 0xa0, 0x3d, 0xb6, 0xa3, 0x23, 0x51, 0xcf, 0xeb, 0x96, 0x80, 0xcc, 0x4f, 0x60, 0x69, 0xc7,
+	0x1e, 0x79, 0x81, 0x9d, 0x50, 0xf7, 0xf3, 0x94, 0x46, 0x27, 0x33, 0xf9, 0x17, 0x1c, 0xd4, 0x12,
+	0x87, 0x1f, 0x41, 0x27, 0x8b, 0x12, 0x52, 0x57, 0xd5, 0x2d, 0x75, 0x55, 0x5a, 0xfd, 0x9c, 0x53,
+	0x2f, 0xcf, 0x39, 0xb3, 0x70, 0xd1, 0x2a, 0xc2, 0x05, 0x7f, 0x48, 0xcb, 0x86, 0x5a, 0xe6, 0xf7,
+	0x60, 0xb8, 0x13, 0x4e, 0x52, 0xdf, 0x8e, 0xb0, 0x9a, 0x39, 0xb4, 0x83, 0x11, 0xe5, 0xfb, 0xb5,
+	0x63, 0x79, 0xbf, 0x08, 0x49, 0x83, 0x31, 0xb5, 0x3a, 0x18, 0x73, 0xa9, 0x9f, 0xd8, 0xd9, 0xbb,
+	0x24, 0x03, 0x70, 0x32, 0x07, 0x7b, 0x18, 0xe4, 0xaa, 0x8f, 0xf7, 0x5c, 0x91, 0xab, 0xa0, 0x1f,
+	0x79, 0x81, 0x2b, 0xde, 0x9d, 0xa4, 0x56, 0x80, 0xd3, 0x3c, 0xf5, 0x02, 0xd7, 0x62, 0x18, 0xac,
+	0x01, 0x8c, 0xc2, 0x49, 0x18, 0xe7, 0xad, 0x53, 0x0e, 0x4b, 0x9e, 0x29, 0xba, 0x27, 0x0e, 0xe1,
+	0xba, 0xed, 0x24, 0xde, 0x31, 0x57, 0xbd, 0x63, 0x09, 0x08, 0x9f, 0xbb, 0x39, 0xff, 0x67, 0x5e,
+	0x9c, 0xbc, 0xf6, 0xd9, 0x9b, 0x3f, 0x57, 0x60, 0xc8, 0xf9, 0xec, 0xf0, 0x9d, 0xbc, 0x91, 0x1b,
+	0x49, 0x55, 0x40, 0xa1, 0x41, 0x66, 0x1f, 0xfd, 0x34, 0xfb, 0x98, 0x9f, 0x43, 0x97, 0xaf, 0xbd,
+	0xfe, 0x26, 0xf8, 0xe1, 0x68, 0xd9, 0xe1, 0x98, 0xbf, 0x50, 0xa0, 0xfb, 0xe2, 0xe0, 0x80, 0x46,
+	0xb5, 0x47, 0x57, 0x97, 0x98, 0xae, 0x43, 0x6b, 0x14, 0x86, 0xee, 0xbc, 0x79, 0x18, 0x4b, 0xba,
+	0x0c, 0x09, 0xb1, 0x27, 0x91, 0xe7, 0xd0, 0xd3, 0x52, 0x34, 0x43, 0x32, 0x3f, 0x86, 0xc1, 0x36,
+	0xde, 0x8c, 0xe4, 0xb4, 0x43, 0x2b, 0x5f, 0xa8, 0x6e, 0x7e, 0xa1, 0x7e, 0xad, 0x64, 0x1c, 0x76,
+	0x42, 0xce, 0xa1, 0x59, 0xdc, 0xcb, 0x95, 0x52, 0xcf, 0xa4, 0x94, 0xd6, 0x44, 0xa9, 0x67, 0xb0,
+	0xc4, 0xb7, 0xc4, 0xec, 0x7c, 0xb6, 0x8c, 0x8a, 0x14, 0x79, 0x46, 0x45, 0xe0, 0xda, 0x57, 0xb0,
+	0x5c, 0x79, 0xaf, 0x25, 0x3d, 0x58, 0xfc, 0x22, 0xc0, 0x48, 0x1c, 0x0c, 0x17, 0x10, 0x10, 0x49,
+	0x6d, 0xa8, 0x90, 0x0e, 0xe8, 0x5f, 0xd9, 0x5e, 0x32, 0x54, 0xf1, 0x2f, 0x7c, 0xb3, 0x1d, 0x6a,
+	0x04, 0xa0, 0xbd, 0xc1, 0xde, 0xb4, 0x86, 0x3a, 0xfe, 0xfd, 0x10, 0x9f, 0xb1, 0xdc, 0x61, 0xeb,
+	0xda, 0x1e, 0x0c, 0x4a, 0x7d, 0x08, 0x19, 0x42, 0x9f, 0x2d, 0x3c, 0xa4, 0x07, 0xf8, 0x7a, 0x3b,
+	0x5c, 0x20, 0xcb, 0xd0, 0x63, 0x2b, 0x96, 0x1d, 0xb8, 0xe1, 0x78, 0xa8, 0x90, 0x73, 0x82, 0xe6,
+	0xb1, 0x1d, 0x25, 0x87, 0x34, 0x46, 0x41, 0x03, 0xe8, 0xb2, 0xa5, 0x2d, 0xea, 0xfb, 0x43, 0xed,
+	0xda, 0x0b, 0x80, 0xc2, 0x8d, 0x49, 0x1f, 0x3a, 0xcf, 0x43, 0x0e, 0x0f, 0x17, 0x10, 0xda, 0xf0,
+	0x7d, 0x0f, 0x9b, 0x34, 0xce, 0xeb, 0x79, 0x18, 0x6c, 0x8c, 0x46, 0x11, 0x8d, 0x63, 0x2f, 0x0c,
+	0x86, 0x2a, 0xe9, 0x42, 0x6b, 0x2f, 0xb2, 0x5d, 0xdc, 0xf5, 0x22, 0x68, 0x5f, 0xd9, 0xd1, 0x50,
+	0x5f, 0xff, 0x8b, 0x0e, 0xad, 0x0d, 0x77, 0xec, 0x05, 0xe4, 0x01, 0xf4, 0xb3, 0xd2, 0x8a, 0xf9,
+	0xc4, 0x5b, 0xf2, 0x31, 0x94, 0x7e, 0x7f, 0xb6, 0xb2, 0x54, 0x7c, 0x62, 0x3f, 0x63, 0x5b, 0x20,
+	0x37, 0x61, 0x51, 0xfc, 0x24, 0x8a, 0x90, 0x2a, 0xdd, 0x13, 0xb7, 0x86, 0xe0, 0x3a, 0x37, 0x60,
+	0x43, 0xec, 0xfb, 0xd0, 0xfd, 0x94, 0x26, 0xbb, 0x4e, 0xc8, 0x46, 0x37, 0x35, 0x24, 0xb5, 0x03,
+	0x24, 0x73, 0xe1, 0x96, 0x42, 0x3e, 0x86, 0xa5, 0xac, 0xcc, 0x17, 0x99, 0xe1, 0xed, 0xe9, 0x41,
+	0xd5, 0x3c, 0xd5, 0x1e, 0x40, 0x1f, 0xd3, 0x65, 0x3e, 0x0d, 0x91, 0xec, 0x52, 0x49, 0xa7, 0xb5,
+	0xc4, 0x3d, 0xb6, 0x71, 0x3e, 0xc8, 0xa8, 0xdd, 0xfa, 0x8c, 0xf1, 0x87, 0xb9, 0x40, 0x3e, 0x80,
+	0xde, 0xae, 0x44, 0x7c, 0x71, 0x1a, 0xb1, 0x5e, 0xe8, 0xfb, 0x30, 0x60, 0xc3, 0x80, 0xb9, 0x62,
+	0xa7, 0xc9, 0x3e, 0x80, 0x25, 0x8b, 0xc6, 0xe9, 0xf8, 0x8c, 0x74, 0xeb, 0xbf, 0x1b, 0x80, 0xce,
+	0x06, 0x99, 0x0f, 0x40, 0xc7, 0x40, 0x23, 0x5b, 0xa8, 0x52, 0x31, 0xcc, 0x3d, 0xa7, 0xee, 0x86,
+	0xef, 0x73, 0x7c, 0x22, 0x8f, 0xc5, 0x4a, 0x25, 0xc1, 0x1c, 0x06, 0x6b, 0xa0, 0xef, 0x1e, 0x86,
+	0xaf, 0xc8, 0xd4, 0xfd, 0x5f, 0xa9, 0x29, 0x89, 0xcc, 0x05, 0x7c, 0x26, 0x66, 0x2d, 0xb9, 0xac,
+	0x65, 0xd6, 0xa3, 0xd7, 0x58, 0xe7, 0x06, 0xb4, 0x58, 0x6f, 0x2c, 0xa3, 0x67, 0xcd, 0x72, 0x3d,
+	0x3a, 0x6b, 0xcc, 0x65, 0xf4, 0xac, 0x53, 0xaf, 0x41, 0xff, 0x10, 0xa0, 0x68, 0x46, 0x89, 0x3c,
+	0xcb, 0x90, 0x5b, 0xd4, 0x1a, 0xc2, 0x0d, 0x58, 0xae, 0xf4, 0xd6, 0xe4, 0x4a, 0x95, 0x5a, 0x6e,
+	0xbb, 0xeb, 0x1d, 0x5c, 0xee, 0x54, 0xe5, 0xe3, 0xab, 0x74, 0xb0, 0x35, 0xc4, 0x8f, 0xe0, 0xdc,
+	0x54, 0x17, 0x4a, 0xde, 0x9d, 0xe6, 0x20, 0xb7, 0xa8, 0xf5, 0xfa, 0xa3, 0xeb, 0x6c, 0xf0, 0xb1,
+	0xfc, 0xf4, 0x11, 0x4a, 0x73, 0xd5, 0xfc, 0xf7, 0x96, 0xec, 0xd4, 0xef, 0xc3, 0x80, 0x13, 0x66,
+	0xef, 0x32, 0x73, 0x8f, 0x3f, 0x43, 0x63, 0xa4, 0x5b, 0x30, 0xcc, 0x76, 0x97, 0xad, 0x93, 0x77,
+	0xa6, 0x77, 0x2e, 0x35, 0xb7, 0x35, 0x1b, 0xbf, 0x2b, 0x6a, 0xf4, 0x63, 0x6a, 0xfb, 0xb2, 0x6c,
+	0xb6, 0xf8, 0x72, 0xe5, 0x7c, 0x65, 0x05, 0x4f, 0xc1, 0x5c, 0x20, 0xf7, 0xa0, 0x93, 0x35, 0x13,
+	0xa5, 0x6b, 0x5d, 0x34, 0x18, 0xb3, 0x28, 0xd7, 0x61, 0x71, 0x97, 0x26, 0xd8, 0x16, 0x91, 0x8b,
+	0x65, 0x4d, 0x45, 0xab, 0x54, 0x7b, 0xb1, 0xbb, 0xbb, 0x34, 0xe1, 0x2d, 0x2d, 0xa9, 0xcc, 0x83,
+	0xf3, 0x46, 0xb7, 0x86, 0xee, 0x93, 0xe2, 0x6c, 0x5f, 0x60, 0xfb, 0x19, 0x1f, 0x7a, 0x93, 0xca,
+	0xe5, 0x96, 0x3b, 0xdb, 0x1a, 0x0e, 0x77, 0xa0, 0xcd, 0x7b, 0xd5, 0xaa, 0xd8, 0xbc, 0x83, 0xad,
+	0x0d, 0x5f, 0xdd, 0xbc, 0x33, 0x25, 0x52, 0x74, 0x94, 0xdb, 0xd5, 0x7a, 0x37, 0x96, 0xdb, 0x4f,
+	0x79, 0xa3, 0x95, 0xb6, 0xb4, 0x86, 0x78, 0x13, 0x96, 0xd0, 0x8d, 0x8a, 0x8a, 0xbe, 0xc6, 0x8f,
+	0xa4, 0xd7, 0xb5, 0x6a, 0xe5, 0xcf, 0xfc, 0xe9, 0xff, 0x60, 0xb0, 0x4b, 0x93, 0x62, 0xa2, 0x2b,
+	0xeb, 0x5c, 0x9a, 0xf3, 0xd6, 0xeb, 0x9c, 0xcf, 0x6f, 0x65, 0x9d, 0xe5, 0xa1, 0x6e, 0x0d, 0xd9,
+	0xff, 0xc3, 0x52, 0x26, 0x52, 0xcc, 0xc6, 0x8d, 0xfa, 0x39, 0xe4, 0x8c, 0x4b, 0xb7, 0xc4, 0x47,
+	0xba, 0xd9, 0x1b, 0x1c, 0x91, 0x9c, 0x2e, 0x1f, 0xf6, 0xd6, 0x10, 0xde, 0x83, 0x65, 0xfe, 0x39,
+	0x7f, 0x85, 0x6b, 0x4a, 0x79, 0x07, 0x80, 0x7f, 0x66, 0x91, 0xa6, 0x21, 0xd1, 0x87, 0xd0, 0x62,
+	0x35, 0x10, 0xa9, 0x3c, 0xc7, 0x64, 0x33, 0xe4, 0x59, 0x49, 0x61, 0xfd, 0x1b, 0x15, 0x7a, 0x0f,
+	0xe9, 0x81, 0x17, 0x78, 0x38, 0xd5, 0x8f, 0xc9, 0x06, 0x74, 0xf1, 0x94, 0xb9, 0x7f, 0xcc, 0xce,
+	0x31, 0x33, 0x1e, 0x43, 0xd9, 0x21, 0x3f, 0xe1, 0xf1, 0x66, 0x33, 0x7f, 0x58, 0x98, 0xcd, 0x66,
+	0xce, 0x6b, 0x2c, 0x63, 0xf5, 0x94, 0xfb, 0xdc, 0xd3, 0xe2, 0xe9, 0x72, 0x36, 0xaf, 0x79, 0x8f,
+	0xc2, 0xf2, 0xbe, 0x8a, 0x38, 0xd8, 0x68, 0x5f, 0xd5, 0x5f, 0x07, 0x21, 0xab, 0xf5, 0x3f, 0xb4,
+	0x40, 0x67, 0x69, 0xa8, 0x26, 0xa3, 0xf2, 0xdf, 0x4f, 0xae, 0xd4, 0xbc, 0xa9, 0xb2, 0x9a, 0xae,
+	0xcd, 0x8f, 0xb1, 0x86, 0x62, 0xfa, 0x54, 0xaf, 0x81, 0xfe, 0xd8, 0xa7, 0x76, 0x73, 0x5c, 0x6f,
+	0xd2, 0x08, 0xf7, 0xa6, 0xa8, 0x2c, 0x2f, 0x4e, 0xff, 0xc4, 0xb2, 0xde, 0xbd, 0xd6, 0x78, 0x55,
+	0x4f, 0x2e, 0x94, 0x09, 0xf8, 0x0f, 0x49, 0x6b, 0x43, 0xbe, 0xa8, 0xf8, 0x89, 0x51, 0xa6, 0x28,
+	0x7e, 0x56, 0x5b, 0x43, 0x75, 0x2b, 0xeb, 0x0d, 0x1a, 0xcb, 0xb9, 0x9d, 0xb7, 0x1e, 0x8d, 0x49,
+	0x3e, 0x61, 0xf1, 0x40, 0xfa, 0x91, 0x36, 0xb9, 0x52, 0xa6, 0x2c, 0xff, 0x7e, 0xbb, 0x86, 0xc3,
+	0x67, 0x70, 0x5e, 0xe2, 0x90, 0xf7, 0xb9, 0xff, 0x55, 0xcf, 0x46, 0xfa, 0x91, 0xf6, 0x8c, 0x88,
+	0x1c, 0x85, 0x93, 0x3c, 0xb9, 0x56, 0x7f, 0xc6, 0x3a, 0x37, 0xb1, 0xd6, 0xa6, 0x8e, 0xd2, 0x2f,
+	0xee, 0xa7, 0x89, 0xd6, 0xff, 0xae, 0x60, 0xee, 0x98, 0xf8, 0xe1, 0xd8, 0x76, 0x4e, 0xc8, 0x7d,
+	0x51, 0x8f, 0x5e, 0xae, 0xce, 0x00, 0x44, 0x3b, 0xbc, 0x32, 0x35, 0x1c, 0xc8, 0xaf, 0xd3, 0x47,
+	0xb0, 0x28, 0x06, 0x14, 0x64, 0xa5, 0x8a, 0x54, 0x4c, 0x2e, 0x66, 0x31, 0x20, 0x37, 0xa1, 0xbd,
+	0xe1, 0x38, 0x74, 0x52, 0x0a, 0x71, 0xf9, 0xac, 0xa1, 0xd6, 0x07, 0x5b, 0x9b, 0x11, 0xb5, 0x8f,
+	0x1a, 0xe2, 0xa3, 0xa2, 0x6d, 0xde, 0x05, 0x93, 0x7b, 0xd3, 0x5a, 0x96, 0x9a, 0x7e, 0xb9, 0x92,
+	0xc8, 0x47, 0x13, 0x4c, 0xc9, 0x0f, 0x40, 0xdf, 0x09, 0xeb, 0x28, 0x77, 0xc2, 0x79, 0x94, 0xe4,
+	0x6e, 0xae, 0x9d, 0x51, 0xa5, 0xcc, 0x7a, 0xf2, 0xfa, 0x6b, 0x23, 0xa2, 0xc3, 0x19, 0xa8, 0x36,
+	0x17, 0xbf, 0xdf, 0x62, 0xff, 0x79, 0x6a, 0xbf, 0xcd, 0xfe, 0xb9, 0xf3, 0xaf, 0x01, 0x00, 0x7a,
+	0xb2, 0x81, 0x9f, 0x53, 0x35, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// AdminClient is the client API for Admin service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type AdminClient interface {
+	// Create a Region with one City per city site of the given map, as
+	// reported by the Map service, then save it.
+	CreateRegion(ctx context.Context, in *RegionCreateReq, opts ...grpc.CallOption) (*None, error)
+	// Have all the Cities on the Region to produce their resources
+	Produce(ctx context.Context, in *RegionId, opts ...grpc.CallOption) (*None, error)
+	// Make all the armies on the Region to move on step
+	Move(ctx context.Context, in *RegionId, opts ...grpc.CallOption) (*None, error)
+	// Compute the scoreboard of the region.
+	GetScores(ctx context.Context, in *RegionId, opts ...grpc.CallOption) (Admin_GetScoresClient, error)
+	// Create an Artifact of the given type and place it in the given City
+	CreateArtifact(ctx context.Context, in *ArtifactCreateReq, opts ...grpc.CallOption) (*None, error)
+	// Start an epidemic in the given City
+	SeedEpidemic(ctx context.Context, in *EpidemicSeedReq, opts ...grpc.CallOption) (*None, error)
+	// Return the schedule of the automatic rounds of the Region
+	GetSchedule(ctx context.Context, in *RegionId, opts ...grpc.CallOption) (*ScheduleView, error)
+	// Set the periods of the automatic rounds of the Region
+	SetSchedule(ctx context.Context, in *ScheduleReq, opts ...grpc.CallOption) (*None, error)
+	// Suspend the automatic rounds of the Region. The rounds may still be
+	// triggered with Produce and Move.
+	PauseSchedule(ctx context.Context, in *RegionId, opts ...grpc.CallOption) (*None, error)
+	// Restart the automatic rounds of the Region. The rounds missed during
+	// the pause are not played again.
+	ResumeSchedule(ctx context.Context, in *RegionId, opts ...grpc.CallOption) (*None, error)
+}
+
+type adminClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewAdminClient(cc *grpc.ClientConn) AdminClient {
+	return &adminClient{cc}
+}
+
+func (c *adminClient) CreateRegion(ctx context.Context, in *RegionCreateReq, opts ...grpc.CallOption) (*None, error) {
+	out := new(None)
+	err := c.cc.Invoke(ctx, "/hege.reg.Admin/CreateRegion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) Produce(ctx context.Context, in *RegionId, opts ...grpc.CallOption) (*None, error) {
+	out := new(None)
+	err := c.cc.Invoke(ctx, "/hege.reg.Admin/Produce", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) Move(ctx context.Context, in *RegionId, opts ...grpc.CallOption) (*None, error) {
+	out := new(None)
+	err := c.cc.Invoke(ctx, "/hege.reg.Admin/Move", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) GetScores(ctx context.Context, in *RegionId, opts ...grpc.CallOption) (Admin_GetScoresClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Admin_serviceDesc.Streams[0], "/hege.reg.Admin/GetScores", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &adminGetScoresClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Admin_GetScoresClient interface {
+	Recv() (*PublicCity, error)
+	grpc.ClientStream
+}
+
+type adminGetScoresClient struct {
+	grpc.ClientStream
+}
+
+func (x *adminGetScoresClient) Recv() (*PublicCity, error) {
+	m := new(PublicCity)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *adminClient) CreateArtifact(ctx context.Context, in *ArtifactCreateReq, opts ...grpc.CallOption) (*None, error) {
+	out := new(None)
+	err := c.cc.Invoke(ctx, "/hege.reg.Admin/CreateArtifact", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) SeedEpidemic(ctx context.Context, in *EpidemicSeedReq, opts ...grpc.CallOption) (*None, error) {
+	out := new(None)
+	err := c.cc.Invoke(ctx, "/hege.reg.Admin/SeedEpidemic", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) GetSchedule(ctx context.Context, in *RegionId, opts ...grpc.CallOption) (*ScheduleView, error) {
+	out := new(ScheduleView)
+	err := c.cc.Invoke(ctx, "/hege.reg.Admin/GetSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) SetSchedule(ctx context.Context, in *ScheduleReq, opts ...grpc.CallOption) (*None, error) {
+	out := new(None)
+	err := c.cc.Invoke(ctx, "/hege.reg.Admin/SetSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) PauseSchedule(ctx context.Context, in *RegionId, opts ...grpc.CallOption) (*None, error) {
+	out := new(None)
+	err := c.cc.Invoke(ctx, "/hege.reg.Admin/PauseSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) ResumeSchedule(ctx context.Context, in *RegionId, opts ...grpc.CallOption) (*None, error) {
+	out := new(None)
+	err := c.cc.Invoke(ctx, "/hege.reg.Admin/ResumeSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AdminServer is the server API for Admin service.
+type AdminServer interface {
+	// Create a Region with one City per city site of the given map, as
+	// reported by the Map service, then save it.
+	CreateRegion(context.Context, *RegionCreateReq) (*None, error)
+	// Have all the Cities on the Region to produce their resources
+	Produce(context.Context, *RegionId) (*None, error)
+	// Make all the armies on the Region to move on step
+	Move(context.Context, *RegionId) (*None, error)
+	// Compute the scoreboard of the region.
+	GetScores(*RegionId, Admin_GetScoresServer) error
+	// Create an Artifact of the given type and place it in the given City
+	CreateArtifact(context.Context, *ArtifactCreateReq) (*None, error)
+	// Start an epidemic in the given City
+	SeedEpidemic(context.Context, *EpidemicSeedReq) (*None, error)
+	// Return the schedule of the automatic rounds of the Region
+	GetSchedule(context.Context, *RegionId) (*ScheduleView, error)
+	// Set the periods of the automatic rounds of the Region
+	SetSchedule(context.Context, *ScheduleReq) (*None, error)
+	// Suspend the automatic rounds of the Region. The rounds may still be
+	// triggered with Produce and Move.
+	PauseSchedule(context.Context, *RegionId) (*None, error)
+	// Restart the automatic rounds of the Region. The rounds missed during
+	// the pause are not played again.
+	ResumeSchedule(context.Context, *RegionId) (*None, error)
+}
+
+// UnimplementedAdminServer can be embedded to have forward compatible implementations.
+type UnimplementedAdminServer struct {
+}
+
+func (*UnimplementedAdminServer) CreateRegion(ctx context.Context, req *RegionCreateReq) (*None, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRegion not implemented")
+}
+func (*UnimplementedAdminServer) Produce(ctx context.Context, req *RegionId) (*None, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Produce not implemented")
+}
+func (*UnimplementedAdminServer) Move(ctx context.Context, req *RegionId) (*None, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Move not implemented")
+}
+func (*UnimplementedAdminServer) GetScores(req *RegionId, srv Admin_GetScoresServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetScores not implemented")
+}
+func (*UnimplementedAdminServer) CreateArtifact(ctx context.Context, req *ArtifactCreateReq) (*None, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateArtifact not implemented")
+}
+func (*UnimplementedAdminServer) SeedEpidemic(ctx context.Context, req *EpidemicSeedReq) (*None, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SeedEpidemic not implemented")
+}
+func (*UnimplementedAdminServer) GetSchedule(ctx context.Context, req *RegionId) (*ScheduleView, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSchedule not implemented")
+}
+func (*UnimplementedAdminServer) SetSchedule(ctx context.Context, req *ScheduleReq) (*None, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSchedule not implemented")
+}
+func (*UnimplementedAdminServer) PauseSchedule(ctx context.Context, req *RegionId) (*None, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseSchedule not implemented")
+}
+func (*UnimplementedAdminServer) ResumeSchedule(ctx context.Context, req *RegionId) (*None, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeSchedule not implemented")
+}
+
+func RegisterAdminServer(s *grpc.Server, srv AdminServer) {
+	s.RegisterService(&_Admin_serviceDesc, srv)
+}
+
+func _Admin_CreateRegion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegionCreateReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).CreateRegion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hege.reg.Admin/CreateRegion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).CreateRegion(ctx, req.(*RegionCreateReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_Produce_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegionId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).Produce(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hege.reg.Admin/Produce",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).Produce(ctx, req.(*RegionId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_Move_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegionId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).Move(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hege.reg.Admin/Move",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).Move(ctx, req.(*RegionId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_GetScores_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(RegionId)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AdminServer).GetScores(m, &adminGetScoresServer{stream})
+}
+
+type Admin_GetScoresServer interface {
+	Send(*PublicCity) error
+	grpc.ServerStream
+}
+
+type adminGetScoresServer struct {
+	grpc.ServerStream
+}
+
+func (x *adminGetScoresServer) Send(m *PublicCity) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Admin_CreateArtifact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArtifactCreateReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).CreateArtifact(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hege.reg.Admin/CreateArtifact",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).CreateArtifact(ctx, req.(*ArtifactCreateReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_SeedEpidemic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EpidemicSeedReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).SeedEpidemic(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hege.reg.Admin/SeedEpidemic",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).SeedEpidemic(ctx, req.(*EpidemicSeedReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_GetSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegionId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).GetSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hege.reg.Admin/GetSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).GetSchedule(ctx, req.(*RegionId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_SetSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScheduleReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).SetSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hege.reg.Admin/SetSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).SetSchedule(ctx, req.(*ScheduleReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_PauseSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegionId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).PauseSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hege.reg.Admin/PauseSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).PauseSchedule(ctx, req.(*RegionId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_ResumeSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegionId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).ResumeSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hege.reg.Admin/ResumeSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).ResumeSchedule(ctx, req.(*RegionId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Admin_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "hege.reg.Admin",
+	HandlerType: (*AdminServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateRegion",
+			Handler:    _Admin_CreateRegion_Handler,
+		},
+		{
+			MethodName: "Produce",
+			Handler:    _Admin_Produce_Handler,
+		},
+		{
+			MethodName: "Move",
+			Handler:    _Admin_Move_Handler,
+		},
+		{
+			MethodName: "CreateArtifact",
+			Handler:    _Admin_CreateArtifact_Handler,
+		},
+		{
+			MethodName: "SeedEpidemic",
+			Handler:    _Admin_SeedEpidemic_Handler,
+		},
+		{
+			MethodName: "GetSchedule",
+			Handler:    _Admin_GetSchedule_Handler,
+		},
+		{
+			MethodName: "SetSchedule",
+			Handler:    _Admin_SetSchedule_Handler,
+		},
+		{
+			MethodName: "PauseSchedule",
+			Handler:    _Admin_PauseSchedule_Handler,
+		},
+		{
+			MethodName: "ResumeSchedule",
+			Handler:    _Admin_ResumeSchedule_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "GetScores",
+			Handler:       _Admin_GetScores_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "region.proto",
+}
+
+// CityClient is the client API for City service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type CityClient interface {
+	// Paginated query of the cities owned by the given character.
+	// Only a summary of the cities are returned.
+	List(ctx context.Context, in *CitiesByCharReq, opts ...grpc.CallOption) (City_ListClient, error)
+	// Paginated query of all the cities of the region.
+	// Only a summary of the cities are returned.
+	AllCities(ctx context.Context, in *PaginatedQuery, opts ...grpc.CallOption) (City_AllCitiesClient, error)
+	// Returns a complete view of the City
+	// TODO(jfs): the request might fail because of a too large object
+	//            to be replied.
+	Show(ctx context.Context, in *CityId, opts ...grpc.CallOption) (*CityView, error)
+	// Start the study of a knowledge whose type is specified by its unique ID.
+	// If the conditions are not met, an error is returned.
+	Study(ctx context.Context, in *StudyReq, opts ...grpc.CallOption) (*None, error)
+	// Start the construction of a building whose type is specified by its unique ID.
+	// If the conditions are not met, an error is returned.
+	Build(ctx context.Context, in *BuildReq, opts ...grpc.CallOption) (*None, error)
+	// Start the training of a Unit whose type is specified by its unique ID.
+	// If the conditions are not met, an error is returned.
+	Train(ctx context.Context, in *TrainReq, opts ...grpc.CallOption) (*None, error)
+	// Create an army around a set of units.
+	// The set of units must not be empty and all the units must stay in the given City.
+	CreateArmy(ctx context.Context, in *CreateArmyReq, opts ...grpc.CallOption) (*None, error)
+	// Create an army around a pile of resources, with a given destination.
+	// The army immediately preempts the stock in the reserve of the City
+	// and starts it movement. That army will have no aggressivity.
+	CreateTransport(ctx context.Context, in *CreateTransportReq, opts ...grpc.CallOption) (*None, error)
+	// Transfer a Unit from the given City to the given Army.
+	// The City must control the Army and the Unit must be in the City.
+	TransferUnit(ctx context.Context, in *TransferUnitReq, opts ...grpc.CallOption) (*None, error)
+	// Transfer a pile of Resources from the given City to the given Army.
+	// The City must control the Army and the Stock must hold the amount of Resources.
+	TransferResources(ctx context.Context, in *TransferResourcesReq, opts ...grpc.CallOption) (*None, error)
+	// Return the list of armies that can be controlled by the given City
+	ListArmies(ctx context.Context, in *CityId, opts ...grpc.CallOption) (City_ListArmiesClient, error)
+	// Return the list of the Artifacts placed in the given City
+	ListArtifacts(ctx context.Context, in *CityId, opts ...grpc.CallOption) (City_ListArtifactsClient, error)
+	// Transfer an Artifact from the given City to the given Army.
+	// The City must control the Army and the Artifact must be in the City.
+	TransferArtifact(ctx context.Context, in *TransferArtifactReq, opts ...grpc.CallOption) (*None, error)
+	// Send an intelligence operation to reveal the complete view of the target City.
+	// The view is only present in the report when the operation succeeded.
+	SpyReveal(ctx context.Context, in *SpyReq, opts ...grpc.CallOption) (*SpyReport, error)
+	// Send an intelligence operation to steal a Knowledge learned by the target City.
+	SpySteal(ctx context.Context, in *SpyStealReq, opts ...grpc.CallOption) (*SpyReport, error)
+	// Toggle the automatic mode of the City: its armies are recalled to defend
+	// it and a conservative evolution may be followed at each production round.
+	SetAuto(ctx context.Context, in *CityAutoReq, opts ...grpc.CallOption) (*None, error)
+	// Name the deputy of the City, or revoke the current deputy if the
+	// deputy field is empty. Only the owner of the City is allowed to do so.
+	SetDeputy(ctx context.Context, in *CityDeputyReq, opts ...grpc.CallOption) (*None, error)
+	// Give the City to another character. Only the owner of the City is
+	// allowed to do so, and the deputy is revoked.
+	TransferOwnership(ctx context.Context, in *CityTransferReq, opts ...grpc.CallOption) (*None, error)
+	// Change the display name of the City
+	Rename(ctx context.Context, in *CityRenameReq, opts ...grpc.CallOption) (*None, error)
+	// Dismantle a Building of the City. A part of the resources actually paid for
+	// its construction is given back.
+	Dismantle(ctx context.Context, in *DismantleReq, opts ...grpc.CallOption) (*None, error)
+	// Disband Units that stay idle in the City.
+	DisbandUnits(ctx context.Context, in *DisbandUnitsReq, opts ...grpc.CallOption) (*None, error)
+	// Return the last permanent changes of the Popularity of the City,
+	// the oldest first.
+	ListPopularity(ctx context.Context, in *CityId, opts ...grpc.CallOption) (City_ListPopularityClient, error)
+	// Set the priority of the items in progress in the City. The listed items
+	// come first, in the given order, then the others in the default order.
+	SetQueueOrder(ctx context.Context, in *QueueOrderReq, opts ...grpc.CallOption) (*None, error)
+	// Pause or resume an item in progress in the City
+	PauseItem(ctx context.Context, in *PauseItemReq, opts ...grpc.CallOption) (*None, error)
+	// Set the ratio of the leftover stock each category of items may spend
+	// at each production round.
+	SetQueueBudget(ctx context.Context, in *QueueBudgetReq, opts ...grpc.CallOption) (*None, error)
+	// Cancel the construction of a Building. A part of the resources already
+	// spent on it is given back to the City.
+	CancelBuilding(ctx context.Context, in *CancelReq, opts ...grpc.CallOption) (*None, error)
+	// Cancel the study of a Knowledge. A part of the resources already
+	// spent on it is given back to the City.
+	CancelKnowledge(ctx context.Context, in *CancelReq, opts ...grpc.CallOption) (*None, error)
+	// Cancel the training of a Unit. A part of the resources already
+	// spent on it is given back to the City.
+	CancelUnit(ctx context.Context, in *CancelReq, opts ...grpc.CallOption) (*None, error)
+	// Give a free City to a Character that owns no City in the Region yet.
+	// The City is picked according to the strategy, or to the strategy
+	// configured in the Region if none is specified.
+	Claim(ctx context.Context, in *CityClaimReq, opts ...grpc.CallOption) (*PublicCity, error)
+}
+
+type cityClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewCityClient(cc *grpc.ClientConn) CityClient {
+	return &cityClient{cc}
+}
+
+func (c *cityClient) List(ctx context.Context, in *CitiesByCharReq, opts ...grpc.CallOption) (City_ListClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_City_serviceDesc.Streams[0], "/hege.reg.City/List", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &cityListClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type City_ListClient interface {
+	Recv() (*PublicCity, error)
+	grpc.ClientStream
+}
+
+type cityListClient struct {
+	grpc.ClientStream
+}
+
+func (x *cityListClient) Recv() (*PublicCity, error) {
+	m := new(PublicCity)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *cityClient) AllCities(ctx context.Context, in *PaginatedQuery, opts ...grpc.CallOption) (City_AllCitiesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_City_serviceDesc.Streams[1], "/hege.reg.City/AllCities", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &cityAllCitiesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type City_AllCitiesClient interface {
+	Recv() (*PublicCity, error)
+	grpc.ClientStream
+}
+
+type cityAllCitiesClient struct {
+	grpc.ClientStream
+}
+
+func (x *cityAllCitiesClient) Recv() (*PublicCity, error) {
+	m := new(PublicCity)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *cityClient) Show(ctx context.Context, in *CityId, opts ...grpc.CallOption) (*CityView, error) {
+	out := new(CityView)
+	err := c.cc.Invoke(ctx, "/hege.reg.City/Show", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cityClient) Study(ctx context.Context, in *StudyReq, opts ...grpc.CallOption) (*None, error) {
+	out := new(None)
+	err := c.cc.Invoke(ctx, "/hege.reg.City/Study", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cityClient) Build(ctx context.Context, in *BuildReq, opts ...grpc.CallOption) (*None, error) {
+	out := new(None)
+	err := c.cc.Invoke(ctx, "/hege.reg.City/Build", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cityClient) Train(ctx context.Context, in *TrainReq, opts ...grpc.CallOption) (*None, error) {
+	out := new(None)
+	err := c.cc.Invoke(ctx, "/hege.reg.City/Train", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cityClient) CreateArmy(ctx context.Context, in *CreateArmyReq, opts ...grpc.CallOption) (*None, error) {
+	out := new(None)
+	err := c.cc.Invoke(ctx, "/hege.reg.City/CreateArmy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cityClient) CreateTransport(ctx context.Context, in *CreateTransportReq, opts ...grpc.CallOption) (*None, error) {
+	out := new(None)
+	err := c.cc.Invoke(ctx, "/hege.reg.City/CreateTransport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cityClient) TransferUnit(ctx context.Context, in *TransferUnitReq, opts ...grpc.CallOption) (*None, error) {
+	out := new(None)
+	err := c.cc.Invoke(ctx, "/hege.reg.City/TransferUnit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cityClient) TransferResources(ctx context.Context, in *TransferResourcesReq, opts ...grpc.CallOption) (*None, error) {
+	out := new(None)
+	err := c.cc.Invoke(ctx, "/hege.reg.City/TransferResources", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cityClient) ListArmies(ctx context.Context, in *CityId, opts ...grpc.CallOption) (City_ListArmiesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_City_serviceDesc.Streams[2], "/hege.reg.City/ListArmies", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &cityListArmiesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type City_ListArmiesClient interface {
+	Recv() (*NamedItem, error)
+	grpc.ClientStream
+}
+
+type cityListArmiesClient struct {
+	grpc.ClientStream
+}
+
+func (x *cityListArmiesClient) Recv() (*NamedItem, error) {
+	m := new(NamedItem)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *cityClient) ListArtifacts(ctx context.Context, in *CityId, opts ...grpc.CallOption) (City_ListArtifactsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_City_serviceDesc.Streams[3], "/hege.reg.City/ListArtifacts", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &cityListArtifactsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type City_ListArtifactsClient interface {
+	Recv() (*Artifact, error)
+	grpc.ClientStream
+}
+
+type cityListArtifactsClient struct {
+	grpc.ClientStream
+}
+
+func (x *cityListArtifactsClient) Recv() (*Artifact, error) {
+	m := new(Artifact)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *cityClient) TransferArtifact(ctx context.Context, in *TransferArtifactReq, opts ...grpc.CallOption) (*None, error) {
+	out := new(None)
+	err := c.cc.Invoke(ctx, "/hege.reg.City/TransferArtifact", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cityClient) SpyReveal(ctx context.Context, in *SpyReq, opts ...grpc.CallOption) (*SpyReport, error) {
+	out := new(SpyReport)
+	err := c.cc.Invoke(ctx, "/hege.reg.City/SpyReveal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cityClient) SpySteal(ctx context.Context, in *SpyStealReq, opts ...grpc.CallOption) (*SpyReport, error) {
+	out := new(SpyReport)
+	err := c.cc.Invoke(ctx, "/hege.reg.City/SpySteal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cityClient) SetAuto(ctx context.Context, in *CityAutoReq, opts ...grpc.CallOption) (*None, error) {
+	out := new(None)
+	err := c.cc.Invoke(ctx, "/hege.reg.City/SetAuto", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cityClient) SetDeputy(ctx context.Context, in *CityDeputyReq, opts ...grpc.CallOption) (*None, error) {
+	out := new(None)
+	err := c.cc.Invoke(ctx, "/hege.reg.City/SetDeputy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cityClient) TransferOwnership(ctx context.Context, in *CityTransferReq, opts ...grpc.CallOption) (*None, error) {
+	out := new(None)
+	err := c.cc.Invoke(ctx, "/hege.reg.City/TransferOwnership", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cityClient) Rename(ctx context.Context, in *CityRenameReq, opts ...grpc.CallOption) (*None, error) {
+	out := new(None)
+	err := c.cc.Invoke(ctx, "/hege.reg.City/Rename", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cityClient) Dismantle(ctx context.Context, in *DismantleReq, opts ...grpc.CallOption) (*None, error) {
+	out := new(None)
+	err := c.cc.Invoke(ctx, "/hege.reg.City/Dismantle", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cityClient) DisbandUnits(ctx context.Context, in *DisbandUnitsReq, opts ...grpc.CallOption) (*None, error) {
+	out := new(None)
+	err := c.cc.Invoke(ctx, "/hege.reg.City/DisbandUnits", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cityClient) ListPopularity(ctx context.Context, in *CityId, opts ...grpc.CallOption) (City_ListPopularityClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_City_serviceDesc.Streams[4], "/hege.reg.City/ListPopularity", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &cityListPopularityClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type City_ListPopularityClient interface {
+	Recv() (*PopularityChange, error)
+	grpc.ClientStream
+}
+
+type cityListPopularityClient struct {
+	grpc.ClientStream
+}
+
+func (x *cityListPopularityClient) Recv() (*PopularityChange, error) {
+	m := new(PopularityChange)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *cityClient) SetQueueOrder(ctx context.Context, in *QueueOrderReq, opts ...grpc.CallOption) (*None, error) {
+	out := new(None)
+	err := c.cc.Invoke(ctx, "/hege.reg.City/SetQueueOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cityClient) PauseItem(ctx context.Context, in *PauseItemReq, opts ...grpc.CallOption) (*None, error) {
+	out := new(None)
+	err := c.cc.Invoke(ctx, "/hege.reg.City/PauseItem", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cityClient) SetQueueBudget(ctx context.Context, in *QueueBudgetReq, opts ...grpc.CallOption) (*None, error) {
+	out := new(None)
+	err := c.cc.Invoke(ctx, "/hege.reg.City/SetQueueBudget", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cityClient) CancelBuilding(ctx context.Context, in *CancelReq, opts ...grpc.CallOption) (*None, error) {
+	out := new(None)
+	err := c.cc.Invoke(ctx, "/hege.reg.City/CancelBuilding", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cityClient) CancelKnowledge(ctx context.Context, in *CancelReq, opts ...grpc.CallOption) (*None, error) {
+	out := new(None)
+	err := c.cc.Invoke(ctx, "/hege.reg.City/CancelKnowledge", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cityClient) CancelUnit(ctx context.Context, in *CancelReq, opts ...grpc.CallOption) (*None, error) {
+	out := new(None)
+	err := c.cc.Invoke(ctx, "/hege.reg.City/CancelUnit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cityClient) Claim(ctx context.Context, in *CityClaimReq, opts ...grpc.CallOption) (*PublicCity, error) {
+	out := new(PublicCity)
+	err := c.cc.Invoke(ctx, "/hege.reg.City/Claim", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CityServer is the server API for City service.
+type CityServer interface {
+	// Paginated query of the cities owned by the given character.
+	// Only a summary of the cities are returned.
+	List(*CitiesByCharReq, City_ListServer) error
+	// Paginated query of all the cities of the region.
+	// Only a summary of the cities are returned.
+	AllCities(*PaginatedQuery, City_AllCitiesServer) error
+	// Returns a complete view of the City
+	// TODO(jfs): the request might fail because of a too large object
+	//            to be replied.
+	Show(context.Context, *CityId) (*CityView, error)
+	// Start the study of a knowledge whose type is specified by its unique ID.
+	// If the conditions are not met, an error is returned.
+	Study(context.Context, *StudyReq) (*None, error)
+	// Start the construction of a building whose type is specified by its unique ID.
+	// If the conditions are not met, an error is returned.
+	Build(context.Context, *BuildReq) (*None, error)
+	// Start the training of a Unit whose type is specified by its unique ID.
+	// If the conditions are not met, an error is returned.
+	Train(context.Context, *TrainReq) (*None, error)
+	// Create an army around a set of units.
+	// The set of units must not be empty and all the units must stay in the given City.
+	CreateArmy(context.Context, *CreateArmyReq) (*None, error)
+	// Create an army around a pile of resources, with a given destination.
+	// The army immediately preempts the stock in the reserve of the City
+	// and starts it movement. That army will have no aggressivity.
+	CreateTransport(context.Context, *CreateTransportReq) (*None, error)
+	// Transfer a Unit from the given City to the given Army.
+	// The City must control the Army and the Unit must be in the City.
+	TransferUnit(context.Context, *TransferUnitReq) (*None, error)
+	// Transfer a pile of Resources from the given City to the given Army.
+	// The City must control the Army and the Stock must hold the amount of Resources.
+	TransferResources(context.Context, *TransferResourcesReq) (*None, error)
+	// Return the list of armies that can be controlled by the given City
+	ListArmies(*CityId, City_ListArmiesServer) error
+	// Return the list of the Artifacts placed in the given City
+	ListArtifacts(*CityId, City_ListArtifactsServer) error
+	// Transfer an Artifact from the given City to the given Army.
+	// The City must control the Army and the Artifact must be in the City.
+	TransferArtifact(context.Context, *TransferArtifactReq) (*None, error)
+	// Send an intelligence operation to reveal the complete view of the target City.
+	// The view is only present in the report when the operation succeeded.
+	SpyReveal(context.Context, *SpyReq) (*SpyReport, error)
+	// Send an intelligence operation to steal a Knowledge learned by the target City.
+	SpySteal(context.Context, *SpyStealReq) (*SpyReport, error)
+	// Toggle the automatic mode of the City: its armies are recalled to defend
+	// it and a conservative evolution may be followed at each production round.
+	SetAuto(context.Context, *CityAutoReq) (*None, error)
+	// Name the deputy of the City, or revoke the current deputy if the
+	// deputy field is empty. Only the owner of the City is allowed to do so.
+	SetDeputy(context.Context, *CityDeputyReq) (*None, error)
+	// Give the City to another character. Only the owner of the City is
+	// allowed to do so, and the deputy is revoked.
+	TransferOwnership(context.Context, *CityTransferReq) (*None, error)
+	// Change the display name of the City
+	Rename(context.Context, *CityRenameReq) (*None, error)
+	// Dismantle a Building of the City. A part of the resources actually paid for
+	// its construction is given back.
+	Dismantle(context.Context, *DismantleReq) (*None, error)
+	// Disband Units that stay idle in the City.
+	DisbandUnits(context.Context, *DisbandUnitsReq) (*None, error)
+	// Return the last permanent changes of the Popularity of the City,
+	// the oldest first.
+	ListPopularity(*CityId, City_ListPopularityServer) error
+	// Set the priority of the items in progress in the City. The listed items
+	// come first, in the given order, then the others in the default order.
+	SetQueueOrder(context.Context, *QueueOrderReq) (*None, error)
+	// Pause or resume an item in progress in the City
+	PauseItem(context.Context, *PauseItemReq) (*None, error)
+	// Set the ratio of the leftover stock each category of items may spend
+	// at each production round.
+	SetQueueBudget(context.Context, *QueueBudgetReq) (*None, error)
+	// Cancel the construction of a Building. A part of the resources already
+	// spent on it is given back to the City.
+	CancelBuilding(context.Context, *CancelReq) (*None, error)
+	// Cancel the study of a Knowledge. A part of the resources already
+	// spent on it is given back to the City.
+	CancelKnowledge(context.Context, *CancelReq) (*None, error)
+	// Cancel the training of a Unit. A part of the resources already
+	// spent on it is given back to the City.
+	CancelUnit(context.Context, *CancelReq) (*None, error)
+	// Give a free City to a Character that owns no City in the Region yet.
+	// The City is picked according to the strategy, or to the strategy
+	// configured in the Region if none is specified.
+	Claim(context.Context, *CityClaimReq) (*PublicCity, error)
+}
+
+// UnimplementedCityServer can be embedded to have forward compatible implementations.
+type UnimplementedCityServer struct {
+}
+
+func (*UnimplementedCityServer) List(req *CitiesByCharReq, srv City_ListServer) error {
+	return status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (*UnimplementedCityServer) AllCities(req *PaginatedQuery, srv City_AllCitiesServer) error {
+	return status.Errorf(codes.Unimplemented, "method AllCities not implemented")
+}
+func (*UnimplementedCityServer) Show(ctx context.Context, req *CityId) (*CityView, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Show not implemented")
+}
+func (*UnimplementedCityServer) Study(ctx context.Context, req *StudyReq) (*None, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Study not implemented")
+}
+func (*UnimplementedCityServer) Build(ctx context.Context, req *BuildReq) (*None, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Build not implemented")
+}
+func (*UnimplementedCityServer) Train(ctx context.Context, req *TrainReq) (*None, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Train not implemented")
+}
+func (*UnimplementedCityServer) CreateArmy(ctx context.Context, req *CreateArmyReq) (*None, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateArmy not implemented")
+}
+func (*UnimplementedCityServer) CreateTransport(ctx context.Context, req *CreateTransportReq) (*None, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTransport not implemented")
+}
+func (*UnimplementedCityServer) TransferUnit(ctx context.Context, req *TransferUnitReq) (*None, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferUnit not implemented")
+}
+func (*UnimplementedCityServer) TransferResources(ctx context.Context, req *TransferResourcesReq) (*None, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferResources not implemented")
+}
+func (*UnimplementedCityServer) ListArmies(req *CityId, srv City_ListArmiesServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListArmies not implemented")
+}
+func (*UnimplementedCityServer) ListArtifacts(req *CityId, srv City_ListArtifactsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListArtifacts not implemented")
+}
+func (*UnimplementedCityServer) TransferArtifact(ctx context.Context, req *TransferArtifactReq) (*None, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferArtifact not implemented")
+}
+func (*UnimplementedCityServer) SpyReveal(ctx context.Context, req *SpyReq) (*SpyReport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SpyReveal not implemented")
+}
+func (*UnimplementedCityServer) SpySteal(ctx context.Context, req *SpyStealReq) (*SpyReport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SpySteal not implemented")
+}
+func (*UnimplementedCityServer) SetAuto(ctx context.Context, req *CityAutoReq) (*None, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAuto not implemented")
+}
+func (*UnimplementedCityServer) SetDeputy(ctx context.Context, req *CityDeputyReq) (*None, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDeputy not implemented")
+}
+func (*UnimplementedCityServer) TransferOwnership(ctx context.Context, req *CityTransferReq) (*None, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferOwnership not implemented")
+}
+func (*UnimplementedCityServer) Rename(ctx context.Context, req *CityRenameReq) (*None, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Rename not implemented")
+}
+func (*UnimplementedCityServer) Dismantle(ctx context.Context, req *DismantleReq) (*None, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Dismantle not implemented")
+}
+func (*UnimplementedCityServer) DisbandUnits(ctx context.Context, req *DisbandUnitsReq) (*None, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisbandUnits not implemented")
+}
+func (*UnimplementedCityServer) ListPopularity(req *CityId, srv City_ListPopularityServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListPopularity not implemented")
+}
+func (*UnimplementedCityServer) SetQueueOrder(ctx context.Context, req *QueueOrderReq) (*None, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetQueueOrder not implemented")
+}
+func (*UnimplementedCityServer) PauseItem(ctx context.Context, req *PauseItemReq) (*None, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseItem not implemented")
+}
+func (*UnimplementedCityServer) SetQueueBudget(ctx context.Context, req *QueueBudgetReq) (*None, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetQueueBudget not implemented")
+}
+func (*UnimplementedCityServer) CancelBuilding(ctx context.Context, req *CancelReq) (*None, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelBuilding not implemented")
+}
+func (*UnimplementedCityServer) CancelKnowledge(ctx context.Context, req *CancelReq) (*None, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelKnowledge not implemented")
+}
+func (*UnimplementedCityServer) CancelUnit(ctx context.Context, req *CancelReq) (*None, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelUnit not implemented")
+}
+func (*UnimplementedCityServer) Claim(ctx context.Context, req *CityClaimReq) (*PublicCity, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Claim not implemented")
+}
+
+func RegisterCityServer(s *grpc.Server, srv CityServer) {
+	s.RegisterService(&_City_serviceDesc, srv)
+}
+
+func _City_List_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(CitiesByCharReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CityServer).List(m, &cityListServer{stream})
+}
+
+type City_ListServer interface {
+	Send(*PublicCity) error
+	grpc.ServerStream
+}
+
+type cityListServer struct {
+	grpc.ServerStream
+}
+
+func (x *cityListServer) Send(m *PublicCity) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _City_AllCities_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(PaginatedQuery)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CityServer).AllCities(m, &cityAllCitiesServer{stream})
+}
+
+type City_AllCitiesServer interface {
+	Send(*PublicCity) error
+	grpc.ServerStream
+}
+
+type cityAllCitiesServer struct {
+	grpc.ServerStream
+}
+
+func (x *cityAllCitiesServer) Send(m *PublicCity) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _City_Show_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CityId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CityServer).Show(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hege.reg.City/Show",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CityServer).Show(ctx, req.(*CityId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _City_Study_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StudyReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CityServer).Study(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hege.reg.City/Study",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CityServer).Study(ctx, req.(*StudyReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _City_Build_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BuildReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CityServer).Build(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hege.reg.City/Build",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CityServer).Build(ctx, req.(*BuildReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _City_Train_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TrainReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CityServer).Train(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hege.reg.City/Train",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CityServer).Train(ctx, req.(*TrainReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _City_CreateArmy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateArmyReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CityServer).CreateArmy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hege.reg.City/CreateArmy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CityServer).CreateArmy(ctx, req.(*CreateArmyReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _City_CreateTransport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTransportReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CityServer).CreateTransport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hege.reg.City/CreateTransport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CityServer).CreateTransport(ctx, req.(*CreateTransportReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _City_TransferUnit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferUnitReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CityServer).TransferUnit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hege.reg.City/TransferUnit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CityServer).TransferUnit(ctx, req.(*TransferUnitReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _City_TransferResources_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferResourcesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CityServer).TransferResources(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hege.reg.City/TransferResources",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CityServer).TransferResources(ctx, req.(*TransferResourcesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _City_ListArmies_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(CityId)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CityServer).ListArmies(m, &cityListArmiesServer{stream})
+}
+
+type City_ListArmiesServer interface {
+	Send(*NamedItem) error
+	grpc.ServerStream
+}
+
+type cityListArmiesServer struct {
+	grpc.ServerStream
+}
+
+func (x *cityListArmiesServer) Send(m *NamedItem) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _City_ListArtifacts_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(CityId)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CityServer).ListArtifacts(m, &cityListArtifactsServer{stream})
+}
+
+type City_ListArtifactsServer interface {
+	Send(*Artifact) error
+	grpc.ServerStream
+}
+
+type cityListArtifactsServer struct {
+	grpc.ServerStream
+}
+
+func (x *cityListArtifactsServer) Send(m *Artifact) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _City_TransferArtifact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferArtifactReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CityServer).TransferArtifact(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hege.reg.City/TransferArtifact",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CityServer).TransferArtifact(ctx, req.(*TransferArtifactReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _City_SpyReveal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SpyReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CityServer).SpyReveal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hege.reg.City/SpyReveal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CityServer).SpyReveal(ctx, req.(*SpyReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _City_SpySteal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SpyStealReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CityServer).SpySteal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hege.reg.City/SpySteal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CityServer).SpySteal(ctx, req.(*SpyStealReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _City_SetAuto_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CityAutoReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CityServer).SetAuto(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hege.reg.City/SetAuto",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CityServer).SetAuto(ctx, req.(*CityAutoReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _City_SetDeputy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CityDeputyReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CityServer).SetDeputy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hege.reg.City/SetDeputy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CityServer).SetDeputy(ctx, req.(*CityDeputyReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _City_TransferOwnership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CityTransferReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CityServer).TransferOwnership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hege.reg.City/TransferOwnership",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CityServer).TransferOwnership(ctx, req.(*CityTransferReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _City_Rename_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CityRenameReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CityServer).Rename(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hege.reg.City/Rename",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CityServer).Rename(ctx, req.(*CityRenameReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _City_Dismantle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DismantleReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CityServer).Dismantle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hege.reg.City/Dismantle",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CityServer).Dismantle(ctx, req.(*DismantleReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _City_DisbandUnits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisbandUnitsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CityServer).DisbandUnits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hege.reg.City/DisbandUnits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CityServer).DisbandUnits(ctx, req.(*DisbandUnitsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _City_ListPopularity_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(CityId)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CityServer).ListPopularity(m, &cityListPopularityServer{stream})
+}
+
+type City_ListPopularityServer interface {
+	Send(*PopularityChange) error
+	grpc.ServerStream
+}
+
+type cityListPopularityServer struct {
+	grpc.ServerStream
+}
+
+func (x *cityListPopularityServer) Send(m *PopularityChange) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _City_SetQueueOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueueOrderReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CityServer).SetQueueOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hege.reg.City/SetQueueOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CityServer).SetQueueOrder(ctx, req.(*QueueOrderReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _City_PauseItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseItemReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CityServer).PauseItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hege.reg.City/PauseItem",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CityServer).PauseItem(ctx, req.(*PauseItemReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _City_SetQueueBudget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueueBudgetReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CityServer).SetQueueBudget(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hege.reg.City/SetQueueBudget",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CityServer).SetQueueBudget(ctx, req.(*QueueBudgetReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _City_CancelBuilding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CityServer).CancelBuilding(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hege.reg.City/CancelBuilding",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CityServer).CancelBuilding(ctx, req.(*CancelReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _City_CancelKnowledge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CityServer).CancelKnowledge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hege.reg.City/CancelKnowledge",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CityServer).CancelKnowledge(ctx, req.(*CancelReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _City_CancelUnit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CityServer).CancelUnit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hege.reg.City/CancelUnit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CityServer).CancelUnit(ctx, req.(*CancelReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _City_Claim_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CityClaimReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CityServer).Claim(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hege.reg.City/Claim",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CityServer).Claim(ctx, req.(*CityClaimReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _City_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "hege.reg.City",
+	HandlerType: (*CityServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Show",
+			Handler:    _City_Show_Handler,
+		},
+		{
+			MethodName: "Study",
+			Handler:    _City_Study_Handler,
+		},
+		{
+			MethodName: "Build",
+			Handler:    _City_Build_Handler,
+		},
+		{
+			MethodName: "Train",
+			Handler:    _City_Train_Handler,
+		},
+		{
+			MethodName: "CreateArmy",
+			Handler:    _City_CreateArmy_Handler,
+		},
+		{
+			MethodName: "CreateTransport",
+			Handler:    _City_CreateTransport_Handler,
+		},
+		{
+			MethodName: "TransferUnit",
+			Handler:    _City_TransferUnit_Handler,
+		},
+		{
+			MethodName: "TransferResources",
+			Handler:    _City_TransferResources_Handler,
+		},
+		{
+			MethodName: "TransferArtifact",
+			Handler:    _City_TransferArtifact_Handler,
+		},
+		{
+			MethodName: "SpyReveal",
+			Handler:    _City_SpyReveal_Handler,
+		},
+		{
+			MethodName: "SpySteal",
+			Handler:    _City_SpySteal_Handler,
+		},
+		{
+			MethodName: "SetAuto",
+			Handler:    _City_SetAuto_Handler,
+		},
+		{
+			MethodName: "SetDeputy",
+			Handler:    _City_SetDeputy_Handler,
+		},
+		{
+			MethodName: "TransferOwnership",
+			Handler:    _City_TransferOwnership_Handler,
+		},
+		{
+			MethodName: "Rename",
+			Handler:    _City_Rename_Handler,
+		},
+		{
+			MethodName: "Dismantle",
+			Handler:    _City_Dismantle_Handler,
+		},
+		{
+			MethodName: "DisbandUnits",
+			Handler:    _City_DisbandUnits_Handler,
+		},
+		{
+			MethodName: "SetQueueOrder",
+			Handler:    _City_SetQueueOrder_Handler,
+		},
+		{
+			MethodName: "PauseItem",
+			Handler:    _City_PauseItem_Handler,
+		},
+		{
+			MethodName: "SetQueueBudget",
+			Handler:    _City_SetQueueBudget_Handler,
+		},
+		{
+			MethodName: "CancelBuilding",
+			Handler:    _City_CancelBuilding_Handler,
+		},
+		{
+			MethodName: "CancelKnowledge",
+			Handler:    _City_CancelKnowledge_Handler,
+		},
+		{
+			MethodName: "CancelUnit",
+			Handler:    _City_CancelUnit_Handler,
+		},
+		{
+			MethodName: "Claim",
+			Handler:    _City_Claim_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "List",
+			Handler:       _City_List_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "AllCities",
+			Handler:       _City_AllCities_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ListArmies",
+			Handler:       _City_ListArmies_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ListArtifacts",
+			Handler:       _City_ListArtifacts_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ListPopularity",
+			Handler:       _City_ListPopularity_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "region.proto",
+}
+
+// DefinitionsClient is the client API for Definitions service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type DefinitionsClient interface {
+	// Return (a page of) a list of all the Units that are possible in the world
+	ListUnits(ctx context.Context, in *PaginatedQuery, opts ...grpc.CallOption) (Definitions_ListUnitsClient, error)
+	// Return (a page of) a list of all the Buildings that are possible in the world
+	ListBuildings(ctx context.Context, in *PaginatedQuery, opts ...grpc.CallOption) (Definitions_ListBuildingsClient, error)
+	// Return (a page of) a list of all the Knowledge that are possible in the world
+	ListKnowledges(ctx context.Context, in *PaginatedQuery, opts ...grpc.CallOption) (Definitions_ListKnowledgesClient, error)
+	// Return (a page of) a list of all the Artifacts that are possible in the world
+	ListArtifacts(ctx context.Context, in *PaginatedQuery, opts ...grpc.CallOption) (Definitions_ListArtifactsClient, error)
+}
+
+type definitionsClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewDefinitionsClient(cc *grpc.ClientConn) DefinitionsClient {
+	return &definitionsClient{cc}
+}
+
+func (c *definitionsClient) ListUnits(ctx context.Context, in *PaginatedQuery, opts ...grpc.CallOption) (Definitions_ListUnitsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Definitions_serviceDesc.Streams[0], "/hege.reg.Definitions/ListUnits", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &definitionsListUnitsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Definitions_ListUnitsClient interface {
+	Recv() (*UnitTypeView, error)
+	grpc.ClientStream
+}
+
+type definitionsListUnitsClient struct {
+	grpc.ClientStream
+}
+
+func (x *definitionsListUnitsClient) Recv() (*UnitTypeView, error) {
+	m := new(UnitTypeView)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *definitionsClient) ListBuildings(ctx context.Context, in *PaginatedQuery, opts ...grpc.CallOption) (Definitions_ListBuildingsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Definitions_serviceDesc.Streams[1], "/hege.reg.Definitions/ListBuildings", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &definitionsListBuildingsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Definitions_ListBuildingsClient interface {
+	Recv() (*BuildingTypeView, error)
+	grpc.ClientStream
+}
+
+type definitionsListBuildingsClient struct {
+	grpc.ClientStream
+}
+
+func (x *definitionsListBuildingsClient) Recv() (*BuildingTypeView, error) {
+	m := new(BuildingTypeView)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *definitionsClient) ListKnowledges(ctx context.Context, in *PaginatedQuery, opts ...grpc.CallOption) (Definitions_ListKnowledgesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Definitions_serviceDesc.Streams[2], "/hege.reg.Definitions/ListKnowledges", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &definitionsListKnowledgesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Definitions_ListKnowledgesClient interface {
+	Recv() (*KnowledgeTypeView, error)
+	grpc.ClientStream
+}
+
+type definitionsListKnowledgesClient struct {
+	grpc.ClientStream
+}
+
+func (x *definitionsListKnowledgesClient) Recv() (*KnowledgeTypeView, error) {
+	m := new(KnowledgeTypeView)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *definitionsClient) ListArtifacts(ctx context.Context, in *PaginatedQuery, opts ...grpc.CallOption) (Definitions_ListArtifactsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Definitions_serviceDesc.Streams[3], "/hege.reg.Definitions/ListArtifacts", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &definitionsListArtifactsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Definitions_ListArtifactsClient interface {
+	Recv() (*ArtifactTypeView, error)
+	grpc.ClientStream
+}
+
+type definitionsListArtifactsClient struct {
+	grpc.ClientStream
+}
+
+func (x *definitionsListArtifactsClient) Recv() (*ArtifactTypeView, error) {
+	m := new(ArtifactTypeView)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// DefinitionsServer is the server API for Definitions service.
+type DefinitionsServer interface {
+	// Return (a page of) a list of all the Units that are possible in the world
+	ListUnits(*PaginatedQuery, Definitions_ListUnitsServer) error
+	// Return (a page of) a list of all the Buildings that are possible in the world
+	ListBuildings(*PaginatedQuery, Definitions_ListBuildingsServer) error
+	// Return (a page of) a list of all the Knowledge that are possible in the world
+	ListKnowledges(*PaginatedQuery, Definitions_ListKnowledgesServer) error
+	// Return (a page of) a list of all the Artifacts that are possible in the world
+	ListArtifacts(*PaginatedQuery, Definitions_ListArtifactsServer) error
+}
+
+// UnimplementedDefinitionsServer can be embedded to have forward compatible implementations.
+type UnimplementedDefinitionsServer struct {
+}
+
+func (*UnimplementedDefinitionsServer) ListUnits(req *PaginatedQuery, srv Definitions_ListUnitsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListUnits not implemented")
+}
+func (*UnimplementedDefinitionsServer) ListBuildings(req *PaginatedQuery, srv Definitions_ListBuildingsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListBuildings not implemented")
+}
+func (*UnimplementedDefinitionsServer) ListKnowledges(req *PaginatedQuery, srv Definitions_ListKnowledgesServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListKnowledges not implemented")
+}
+func (*UnimplementedDefinitionsServer) ListArtifacts(req *PaginatedQuery, srv Definitions_ListArtifactsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListArtifacts not implemented")
+}
+
+func RegisterDefinitionsServer(s *grpc.Server, srv DefinitionsServer) {
+	s.RegisterService(&_Definitions_serviceDesc, srv)
+}
+
+func _Definitions_ListUnits_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(PaginatedQuery)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DefinitionsServer).ListUnits(m, &definitionsListUnitsServer{stream})
+}
+
+type Definitions_ListUnitsServer interface {
+	Send(*UnitTypeView) error
+	grpc.ServerStream
+}
+
+type definitionsListUnitsServer struct {
+	grpc.ServerStream
+}
+
+func (x *definitionsListUnitsServer) Send(m *UnitTypeView) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Definitions_ListBuildings_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(PaginatedQuery)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DefinitionsServer).ListBuildings(m, &definitionsListBuildingsServer{stream})
+}
+
+type Definitions_ListBuildingsServer interface {
+	Send(*BuildingTypeView) error
+	grpc.ServerStream
+}
+
+type definitionsListBuildingsServer struct {
+	grpc.ServerStream
+}
+
+func (x *definitionsListBuildingsServer) Send(m *BuildingTypeView) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Definitions_ListKnowledges_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(PaginatedQuery)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DefinitionsServer).ListKnowledges(m, &definitionsListKnowledgesServer{stream})
+}
+
+type Definitions_ListKnowledgesServer interface {
+	Send(*KnowledgeTypeView) error
+	grpc.ServerStream
+}
+
+type definitionsListKnowledgesServer struct {
+	grpc.ServerStream
+}
+
+func (x *definitionsListKnowledgesServer) Send(m *KnowledgeTypeView) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Definitions_ListArtifacts_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(PaginatedQuery)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DefinitionsServer).ListArtifacts(m, &definitionsListArtifactsServer{stream})
+}
+
+type Definitions_ListArtifactsServer interface {
+	Send(*ArtifactTypeView) error
+	grpc.ServerStream
+}
+
+type definitionsListArtifactsServer struct {
+	grpc.ServerStream
+}
+
+func (x *definitionsListArtifactsServer) Send(m *ArtifactTypeView) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _Definitions_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "hege.reg.Definitions",
+	HandlerType: (*DefinitionsServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ListUnits",
+			Handler:       _Definitions_ListUnits_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ListBuildings",
+			Handler:       _Definitions_ListBuildings_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ListKnowledges",
+			Handler:       _Definitions_ListKnowledges_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ListArtifacts",
+			Handler:       _Definitions_ListArtifacts_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "region.proto",
+}
+
+// ArmyClient is the client API for Army service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ArmyClient interface {
+	// Return a detailed view of the given Army
+	Show(ctx context.Context, in *ArmyId, opts ...grpc.CallOption) (*ArmyView, error)
+	// Destroy the army and return all its content to the local city
+	// Only works when the city is at home.
+	Cancel(ctx context.Context, in *ArmyId, opts ...grpc.CallOption) (*None, error)
+	// Make the Army flea the fight it is involved in.
+	Flea(ctx context.Context, in *ArmyId, opts ...grpc.CallOption) (*None, error)
+	// Make the Army flip in the fight it is involved in.
+	Flip(ctx context.Context, in *ArmyId, opts ...grpc.CallOption) (*None, error)
+	// Append the specified command on the list of the Army.
+	Move(ctx context.Context, in *ArmyMoveReq, opts ...grpc.CallOption) (*None, error)
+	// Append the specified command on the list of the Army.
+	Wait(ctx context.Context, in *ArmyTarget, opts ...grpc.CallOption) (*None, error)
+	// Append the specified command on the list of the Army.
+	Attack(ctx context.Context, in *ArmyAssaultReq, opts ...grpc.CallOption) (*None, error)
+	// Append the specified command on the list of the Army.
+	Defend(ctx context.Context, in *ArmyTarget, opts ...grpc.CallOption) (*None, error)
+	// Append the specified command on the list of the Army.
+	Disband(ctx context.Context, in *ArmyTarget, opts ...grpc.CallOption) (*None, error)
+	// Set the posture of the Army toward a City.
+	// The Army automatically joins or starts fights according to its postures.
+	SetPostureCity(ctx context.Context, in *ArmyPostureCityReq, opts ...grpc.CallOption) (*None, error)
+	// Set the posture of the Army toward all the cities of a Character.
+	// A posture toward a City has the precedence on the posture toward its owner.
+	SetPostureCharacter(ctx context.Context, in *ArmyPostureCharacterReq, opts ...grpc.CallOption) (*None, error)
+	// Drop an Artifact carried by the Army in the City at the location of the Army.
+	DropArtifact(ctx context.Context, in *ArmyArtifactReq, opts ...grpc.CallOption) (*None, error)
+	// Change the display name of the Army
+	Rename(ctx context.Context, in *ArmyRenameReq, opts ...grpc.CallOption) (*None, error)
+}
+
+type armyClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewArmyClient(cc *grpc.ClientConn) ArmyClient {
+	return &armyClient{cc}
+}
+
+func (c *armyClient) Show(ctx context.Context, in *ArmyId, opts ...grpc.CallOption) (*ArmyView, error) {
+	out := new(ArmyView)
+	err := c.cc.Invoke(ctx, "/hege.reg.Army/Show", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *armyClient) Cancel(ctx context.Context, in *ArmyId, opts ...grpc.CallOption) (*None, error) {
+	out := new(None)
+	err := c.cc.Invoke(ctx, "/hege.reg.Army/Cancel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *armyClient) Flea(ctx context.Context, in *ArmyId, opts ...grpc.CallOption) (*None, error) {
+	out := new(None)
+	err := c.cc.Invoke(ctx, "/hege.reg.Army/Flea", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *armyClient) Flip(ctx context.Context, in *ArmyId, opts ...grpc.CallOption) (*None, error) {
+	out := new(None)
+	err := c.cc.Invoke(ctx, "/hege.reg.Army/Flip", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *armyClient) Move(ctx context.Context, in *ArmyMoveReq, opts ...grpc.CallOption) (*None, error) {
+	out := new(None)
+	err := c.cc.Invoke(ctx, "/hege.reg.Army/Move", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *armyClient) Wait(ctx context.Context, in *ArmyTarget, opts ...grpc.CallOption) (*None, error) {
+	out := new(None)
+	err := c.cc.Invoke(ctx, "/hege.reg.Army/Wait", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *armyClient) Attack(ctx context.Context, in *ArmyAssaultReq, opts ...grpc.CallOption) (*None, error) {
+	out := new(None)
+	err := c.cc.Invoke(ctx, "/hege.reg.Army/Attack", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *armyClient) Defend(ctx context.Context, in *ArmyTarget, opts ...grpc.CallOption) (*None, error) {
+	out := new(None)
+	err := c.cc.Invoke(ctx, "/hege.reg.Army/Defend", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *armyClient) Disband(ctx context.Context, in *ArmyTarget, opts ...grpc.CallOption) (*None, error) {
+	out := new(None)
+	err := c.cc.Invoke(ctx, "/hege.reg.Army/Disband", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *armyClient) SetPostureCity(ctx context.Context, in *ArmyPostureCityReq, opts ...grpc.CallOption) (*None, error) {
+	out := new(None)
+	err := c.cc.Invoke(ctx, "/hege.reg.Army/SetPostureCity", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *armyClient) SetPostureCharacter(ctx context.Context, in *ArmyPostureCharacterReq, opts ...grpc.CallOption) (*None, error) {
+	out := new(None)
+	err := c.cc.Invoke(ctx, "/hege.reg.Army/SetPostureCharacter", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *armyClient) DropArtifact(ctx context.Context, in *ArmyArtifactReq, opts ...grpc.CallOption) (*None, error) {
+	out := new(None)
+	err := c.cc.Invoke(ctx, "/hege.reg.Army/DropArtifact", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *armyClient) Rename(ctx context.Context, in *ArmyRenameReq, opts ...grpc.CallOption) (*None, error) {
+	out := new(None)
+	err := c.cc.Invoke(ctx, "/hege.reg.Army/Rename", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ArmyServer is the server API for Army service.
+type ArmyServer interface {
+	// Return a detailed view of the given Army
+	Show(context.Context, *ArmyId) (*ArmyView, error)
+	// Destroy the army and return all its content to the local city
+	// Only works when the city is at home.
+	Cancel(context.Context, *ArmyId) (*None, error)
+	// Make the Army flea the fight it is involved in.
+	Flea(context.Context, *ArmyId) (*None, error)
+	// Make the Army flip in the fight it is involved in.
+	Flip(context.Context, *ArmyId) (*None, error)
+	// Append the specified command on the list of the Army.
+	Move(context.Context, *ArmyMoveReq) (*None, error)
+	// Append the specified command on the list of the Army.
+	Wait(context.Context, *ArmyTarget) (*None, error)
+	// Append the specified command on the list of the Army.
+	Attack(context.Context, *ArmyAssaultReq) (*None, error)
+	// Append the specified command on the list of the Army.
+	Defend(context.Context, *ArmyTarget) (*None, error)
+	// Append the specified command on the list of the Army.
+	Disband(context.Context, *ArmyTarget) (*None, error)
+	// Set the posture of the Army toward a City.
+	// The Army automatically joins or starts fights according to its postures.
+	SetPostureCity(context.Context, *ArmyPostureCityReq) (*None, error)
+	// Set the posture of the Army toward all the cities of a Character.
+	// A posture toward a City has the precedence on the posture toward its owner.
+	SetPostureCharacter(context.Context, *ArmyPostureCharacterReq) (*None, error)
+	// Drop an Artifact carried by the Army in the City at the location of the Army.
+	DropArtifact(context.Context, *ArmyArtifactReq) (*None, error)
+	// Change the display name of the Army
+	Rename(context.Context, *ArmyRenameReq) (*None, error)
+}
+
+// UnimplementedArmyServer can be embedded to have forward compatible implementations.
+type UnimplementedArmyServer struct {
+}
+
+func (*UnimplementedArmyServer) Show(ctx context.Context, req *ArmyId) (*ArmyView, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Show not implemented")
+}
+func (*UnimplementedArmyServer) Cancel(ctx context.Context, req *ArmyId) (*None, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Cancel not implemented")
+}
+func (*UnimplementedArmyServer) Flea(ctx context.Context, req *ArmyId) (*None, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Flea not implemented")
+}
+func (*UnimplementedArmyServer) Flip(ctx context.Context, req *ArmyId) (*None, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Flip not implemented")
+}
+func (*UnimplementedArmyServer) Move(ctx context.Context, req *ArmyMoveReq) (*None, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Move not implemented")
+}
+func (*UnimplementedArmyServer) Wait(ctx context.Context, req *ArmyTarget) (*None, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Wait not implemented")
+}
+func (*UnimplementedArmyServer) Attack(ctx context.Context, req *ArmyAssaultReq) (*None, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Attack not implemented")
+}
+func (*UnimplementedArmyServer) Defend(ctx context.Context, req *ArmyTarget) (*None, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Defend not implemented")
+}
+func (*UnimplementedArmyServer) Disband(ctx context.Context, req *ArmyTarget) (*None, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Disband not implemented")
+}
+func (*UnimplementedArmyServer) SetPostureCity(ctx context.Context, req *ArmyPostureCityReq) (*None, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPostureCity not implemented")
+}
+func (*UnimplementedArmyServer) SetPostureCharacter(ctx context.Context, req *ArmyPostureCharacterReq) (*None, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPostureCharacter not implemented")
+}
+func (*UnimplementedArmyServer) DropArtifact(ctx context.Context, req *ArmyArtifactReq) (*None, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DropArtifact not implemented")
+}
+func (*UnimplementedArmyServer) Rename(ctx context.Context, req *ArmyRenameReq) (*None, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Rename not implemented")
+}
+
+func RegisterArmyServer(s *grpc.Server, srv ArmyServer) {
+	s.RegisterService(&_Army_serviceDesc, srv)
+}
+
+func _Army_Show_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArmyId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArmyServer).Show(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hege.reg.Army/Show",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArmyServer).Show(ctx, req.(*ArmyId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Army_Cancel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArmyId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArmyServer).Cancel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hege.reg.Army/Cancel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArmyServer).Cancel(ctx, req.(*ArmyId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Army_Flea_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArmyId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArmyServer).Flea(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hege.reg.Army/Flea",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArmyServer).Flea(ctx, req.(*ArmyId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Army_Flip_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArmyId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArmyServer).Flip(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hege.reg.Army/Flip",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArmyServer).Flip(ctx, req.(*ArmyId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Army_Move_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArmyMoveReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArmyServer).Move(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hege.reg.Army/Move",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArmyServer).Move(ctx, req.(*ArmyMoveReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Army_Wait_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArmyTarget)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArmyServer).Wait(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hege.reg.Army/Wait",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArmyServer).Wait(ctx, req.(*ArmyTarget))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Army_Attack_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArmyAssaultReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArmyServer).Attack(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hege.reg.Army/Attack",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArmyServer).Attack(ctx, req.(*ArmyAssaultReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Army_Defend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArmyTarget)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArmyServer).Defend(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hege.reg.Army/Defend",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArmyServer).Defend(ctx, req.(*ArmyTarget))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Army_Disband_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArmyTarget)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArmyServer).Disband(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hege.reg.Army/Disband",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArmyServer).Disband(ctx, req.(*ArmyTarget))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Army_SetPostureCity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArmyPostureCityReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArmyServer).SetPostureCity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hege.reg.Army/SetPostureCity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArmyServer).SetPostureCity(ctx, req.(*ArmyPostureCityReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Army_SetPostureCharacter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArmyPostureCharacterReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArmyServer).SetPostureCharacter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hege.reg.Army/SetPostureCharacter",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArmyServer).SetPostureCharacter(ctx, req.(*ArmyPostureCharacterReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Army_DropArtifact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArmyArtifactReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArmyServer).DropArtifact(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hege.reg.Army/DropArtifact",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArmyServer).DropArtifact(ctx, req.(*ArmyArtifactReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Army_Rename_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArmyRenameReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArmyServer).Rename(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hege.reg.Army/Rename",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArmyServer).Rename(ctx, req.(*ArmyRenameReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Army_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "hege.reg.Army",
+	HandlerType: (*ArmyServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Show",
+			Handler:    _Army_Show_Handler,
+		},
+		{
+			MethodName: "Cancel",
+			Handler:    _Army_Cancel_Handler,
+		},
+		{
+			MethodName: "Flea",
+			Handler:    _Army_Flea_Handler,
+		},
+		{
+			MethodName: "Flip",
+			Handler:    _Army_Flip_Handler,
+		},
+		{
+			MethodName: "Move",
+			Handler:    _Army_Move_Handler,
+		},
+		{
+			MethodName: "Wait",
+			Handler:    _Army_Wait_Handler,
+		},
+		{
+			MethodName: "Attack",
+			Handler:    _Army_Attack_Handler,
+		},
+		{
+			MethodName: "Defend",
+			Handler:    _Army_Defend_Handler,
+		},
+		{
+			MethodName: "Disband",
+			Handler:    _Army_Disband_Handler,
+		},
+		{
+			MethodName: "SetPostureCity",
+			Handler:    _Army_SetPostureCity_Handler,
+		},
+		{
+			MethodName: "SetPostureCharacter",
+			Handler:    _Army_SetPostureCharacter_Handler,
+		},
+		{
+			MethodName: "DropArtifact",
+			Handler:    _Army_DropArtifact_Handler,
+		},
+		{
+			MethodName: "Rename",
+			Handler:    _Army_Rename_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "region.proto",
+}
+
+// DiplomacyClient is the client API for Diplomacy service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type DiplomacyClient interface {
+	// Return the treaties (proposed or in force) the character is a party of.
+	List(ctx context.Context, in *TreatyListReq, opts ...grpc.CallOption) (Diplomacy_ListClient, error)
+	// Propose a treaty to another character.
+	// A declaration of war is immediately in force.
+	Propose(ctx context.Context, in *TreatyProposeReq, opts ...grpc.CallOption) (*TreatyView, error)
+	// Accept a treaty proposed to the character.
+	Accept(ctx context.Context, in *TreatyReq, opts ...grpc.CallOption) (*None, error)
+	// Break a treaty in force, or refuse a treaty proposed to the character.
+	Break(ctx context.Context, in *TreatyReq, opts ...grpc.CallOption) (*None, error)
+}
+
+type diplomacyClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewDiplomacyClient(cc *grpc.ClientConn) DiplomacyClient {
+	return &diplomacyClient{cc}
+}
+
+func (c *diplomacyClient) List(ctx context.Context, in *TreatyListReq, opts ...grpc.CallOption) (Diplomacy_ListClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Diplomacy_serviceDesc.Streams[0], "/hege.reg.Diplomacy/List", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &diplomacyListClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Diplomacy_ListClient interface {
+	Recv() (*TreatyView, error)
+	grpc.ClientStream
+}
+
+type diplomacyListClient struct {
+	grpc.ClientStream
+}
+
+func (x *diplomacyListClient) Recv() (*TreatyView, error) {
+	m := new(TreatyView)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *diplomacyClient) Propose(ctx context.Context, in *TreatyProposeReq, opts ...grpc.CallOption) (*TreatyView, error) {
+	out := new(TreatyView)
+	err := c.cc.Invoke(ctx, "/hege.reg.Diplomacy/Propose", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *diplomacyClient) Accept(ctx context.Context, in *TreatyReq, opts ...grpc.CallOption) (*None, error) {
+	out := new(None)
+	err := c.cc.Invoke(ctx, "/hege.reg.Diplomacy/Accept", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *diplomacyClient) Break(ctx context.Context, in *TreatyReq, opts ...grpc.CallOption) (*None, error) {
+	out := new(None)
+	err := c.cc.Invoke(ctx, "/hege.reg.Diplomacy/Break", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DiplomacyServer is the server API for Diplomacy service.
+type DiplomacyServer interface {
+	// Return the treaties (proposed or in force) the character is a party of.
+	List(*TreatyListReq, Diplomacy_ListServer) error
+	// Propose a treaty to another character.
+	// A declaration of war is immediately in force.
+	Propose(context.Context, *TreatyProposeReq) (*TreatyView, error)
+	// Accept a treaty proposed to the character.
+	Accept(context.Context, *TreatyReq) (*None, error)
+	// Break a treaty in force, or refuse a treaty proposed to the character.
+	Break(context.Context, *TreatyReq) (*None, error)
+}
+
+// UnimplementedDiplomacyServer can be embedded to have forward compatible implementations.
+type UnimplementedDiplomacyServer struct {
+}
+
+func (*UnimplementedDiplomacyServer) List(req *TreatyListReq, srv Diplomacy_ListServer) error {
+	return status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (*UnimplementedDiplomacyServer) Propose(ctx context.Context, req *TreatyProposeReq) (*TreatyView, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Propose not implemented")
+}
+func (*UnimplementedDiplomacyServer) Accept(ctx context.Context, req *TreatyReq) (*None, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Accept not implemented")
+}
+func (*UnimplementedDiplomacyServer) Break(ctx context.Context, req *TreatyReq) (*None, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Break not implemented")
+}
+
+func RegisterDiplomacyServer(s *grpc.Server, srv DiplomacyServer) {
+	s.RegisterService(&_Diplomacy_serviceDesc, srv)
+}
+
+func _Diplomacy_List_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(TreatyListReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DiplomacyServer).List(m, &diplomacyListServer{stream})
+}
+
+type Diplomacy_ListServer interface {
+	Send(*TreatyView) error
+	grpc.ServerStream
+}
+
+type diplomacyListServer struct {
+	grpc.ServerStream
+}
+
+func (x *diplomacyListServer) Send(m *TreatyView) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Diplomacy_Propose_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TreatyProposeReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DiplomacyServer).Propose(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hege.reg.Diplomacy/Propose",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DiplomacyServer).Propose(ctx, req.(*TreatyProposeReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Diplomacy_Accept_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TreatyReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DiplomacyServer).Accept(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hege.reg.Diplomacy/Accept",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DiplomacyServer).Accept(ctx, req.(*TreatyReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Diplomacy_Break_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TreatyReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DiplomacyServer).Break(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hege.reg.Diplomacy/Break",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DiplomacyServer).Break(ctx, req.(*TreatyReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Diplomacy_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "hege.reg.Diplomacy",
+	HandlerType: (*DiplomacyServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Propose",
+			Handler:    _Diplomacy_Propose_Handler,
+		},
+		{
+			MethodName: "Accept",
+			Handler:    _Diplomacy_Accept_Handler,
+		},
+		{
+			MethodName: "Break",
+			Handler:    _Diplomacy_Break_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "List",
+			Handler:       _Diplomacy_List_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "region.proto",
+}
+
+// MarketClient is the client API for Market service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MarketClient interface {
+	// Return (a page of) the offers posted on the market of the region.
+	List(ctx context.Context, in *MarketListReq, opts ...grpc.CallOption) (Market_ListClient, error)
+	// Post an offer on the market: the goods are preempted from the stock
+	// of the City until the offer is accepted or cancelled.
+	Post(ctx context.Context, in *MarketPostReq, opts ...grpc.CallOption) (*OfferView, error)
+	// Accept an offer posted by another City. The City pays the price and
+	// receives the goods, instantly or with transports.
+	Accept(ctx context.Context, in *MarketOfferReq, opts ...grpc.CallOption) (*None, error)
+	// Withdraw an offer posted by the City and give the goods back.
+	Cancel(ctx context.Context, in *MarketOfferReq, opts ...grpc.CallOption) (*None, error)
+}
+
+type marketClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewMarketClient(cc *grpc.ClientConn) MarketClient {
+	return &marketClient{cc}
+}
+
+func (c *marketClient) List(ctx context.Context, in *MarketListReq, opts ...grpc.CallOption) (Market_ListClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Market_serviceDesc.Streams[0], "/hege.reg.Market/List", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &marketListClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Market_ListClient interface {
+	Recv() (*OfferView, error)
+	grpc.ClientStream
+}
+
+type marketListClient struct {
+	grpc.ClientStream
+}
+
+func (x *marketListClient) Recv() (*OfferView, error) {
+	m := new(OfferView)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *marketClient) Post(ctx context.Context, in *MarketPostReq, opts ...grpc.CallOption) (*OfferView, error) {
+	out := new(OfferView)
+	err := c.cc.Invoke(ctx, "/hege.reg.Market/Post", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *marketClient) Accept(ctx context.Context, in *MarketOfferReq, opts ...grpc.CallOption) (*None, error) {
+	out := new(None)
+	err := c.cc.Invoke(ctx, "/hege.reg.Market/Accept", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *marketClient) Cancel(ctx context.Context, in *MarketOfferReq, opts ...grpc.CallOption) (*None, error) {
+	out := new(None)
+	err := c.cc.Invoke(ctx, "/hege.reg.Market/Cancel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MarketServer is the server API for Market service.
+type MarketServer interface {
+	// Return (a page of) the offers posted on the market of the region.
+	List(*MarketListReq, Market_ListServer) error
+	// Post an offer on the market: the goods are preempted from the stock
+	// of the City until the offer is accepted or cancelled.
+	Post(context.Context, *MarketPostReq) (*OfferView, error)
+	// Accept an offer posted by another City. The City pays the price and
+	// receives the goods, instantly or with transports.
+	Accept(context.Context, *MarketOfferReq) (*None, error)
+	// Withdraw an offer posted by the City and give the goods back.
+	Cancel(context.Context, *MarketOfferReq) (*None, error)
+}
+
+// UnimplementedMarketServer can be embedded to have forward compatible implementations.
+type UnimplementedMarketServer struct {
+}
+
+func (*UnimplementedMarketServer) List(req *MarketListReq, srv Market_ListServer) error {
+	return status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (*UnimplementedMarketServer) Post(ctx context.Context, req *MarketPostReq) (*OfferView, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Post not implemented")
+}
+func (*UnimplementedMarketServer) Accept(ctx context.Context, req *MarketOfferReq) (*None, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Accept not implemented")
+}
+func (*UnimplementedMarketServer) Cancel(ctx context.Context, req *MarketOfferReq) (*None, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Cancel not implemented")
+}
+
+func RegisterMarketServer(s *grpc.Server, srv MarketServer) {
+	s.RegisterService(&_Market_serviceDesc, srv)
+}
+
+func _Market_List_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(MarketListReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MarketServer).List(m, &marketListServer{stream})
+}
+
+type Market_ListServer interface {
+	Send(*OfferView) error
+	grpc.ServerStream
+}
+
+type marketListServer struct {
+	grpc.ServerStream
+}
+
+func (x *marketListServer) Send(m *OfferView) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Market_Post_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarketPostReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MarketServer).Post(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hege.reg.Market/Post",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarketServer).Post(ctx, req.(*MarketPostReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Market_Accept_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarketOfferReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MarketServer).Accept(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hege.reg.Market/Accept",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarketServer).Accept(ctx, req.(*MarketOfferReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Market_Cancel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarketOfferReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MarketServer).Cancel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hege.reg.Market/Cancel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarketServer).Cancel(ctx, req.(*MarketOfferReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Market_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "hege.reg.Market",
+	HandlerType: (*MarketServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Post",
+			Handler:    _Market_Post_Handler,
+		},
+		{
+			MethodName: "Accept",
+			Handler:    _Market_Accept_Handler,
+		},
+		{
+			MethodName: "Cancel",
+			Handler:    _Market_Cancel_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "List",
+			Handler:       _Market_List_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "region.proto",
+}
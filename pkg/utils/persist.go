@@ -16,6 +16,10 @@ type PersistencyMapping []CfgSection
 type CfgSection struct {
 	Path string
 	Obj  interface{}
+
+	// A missing file leaves the object untouched instead of failing the Load.
+	// Useful for the sections added after the creation of the live data.
+	Optional bool
 }
 
 func (p PersistencyMapping) Dump() error {
@@ -35,9 +39,26 @@ func (p PersistencyMapping) Dump() error {
 	return nil
 }
 
+// Return a copy of the mapping where each object is replaced by its JSON
+// encoding, so that it can be dumped later while the original objects change.
+func (p PersistencyMapping) Snapshot() (PersistencyMapping, error) {
+	out := make(PersistencyMapping, 0, len(p))
+	for _, section := range p {
+		encoded, err := json.Marshal(section.Obj)
+		if err != nil {
+			return nil, fmt.Errorf("Failed to serialise [%s]: %s", section.Path, err.Error())
+		}
+		out = append(out, CfgSection{Path: section.Path, Obj: json.RawMessage(encoded)})
+	}
+	return out, nil
+}
+
 func (p PersistencyMapping) Load() error {
 	for _, section := range p {
 		in, err := os.Open(section.Path)
+		if err != nil && section.Optional && os.IsNotExist(err) {
+			continue
+		}
 		if err != nil {
 			return fmt.Errorf("Failed to load the World from [%s]: %s", section.Path, err.Error())
 		}
@@ -52,3 +52,43 @@ func doProduce(f *frontService) macaron.Handler {
 		ctx.Redirect("/game/admin")
 	}
 }
+
+func doSchedulePause(f *frontService) macaron.Handler {
+	return func(ctx *macaron.Context, sess session.Store, flash *session.Flash) {
+		_, err := f.authenticateAdminFromSession(ctx, sess)
+		if err != nil {
+			flash.Warning(err.Error())
+			ctx.Redirect("/game/user")
+			return
+		}
+
+		regID := region.RegionId{Region: ctx.Query("reg")}
+
+		cliReg := region.NewAdminClient(f.cnxRegion)
+		_, err = cliReg.PauseSchedule(contextMacaronToGrpc(ctx, sess), &regID)
+		if err != nil {
+			flash.Warning(err.Error())
+		}
+		ctx.Redirect("/game/admin?reg=" + regID.Region)
+	}
+}
+
+func doScheduleResume(f *frontService) macaron.Handler {
+	return func(ctx *macaron.Context, sess session.Store, flash *session.Flash) {
+		_, err := f.authenticateAdminFromSession(ctx, sess)
+		if err != nil {
+			flash.Warning(err.Error())
+			ctx.Redirect("/game/user")
+			return
+		}
+
+		regID := region.RegionId{Region: ctx.Query("reg")}
+
+		cliReg := region.NewAdminClient(f.cnxRegion)
+		_, err = cliReg.ResumeSchedule(contextMacaronToGrpc(ctx, sess), &regID)
+		if err != nil {
+			flash.Warning(err.Error())
+		}
+		ctx.Redirect("/game/admin?reg=" + regID.Region)
+	}
+}
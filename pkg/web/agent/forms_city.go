@@ -62,6 +62,11 @@ type FormCityArmyCreate struct {
 	Name        string `form:"name" binding:"Required"`
 }
 
+type FormCityClaim struct {
+	RegionID    string `form:"reg" binding:"Required"`
+	CharacterID string `form:"cid" binding:"Required"`
+}
+
 func doCityStudy(f *frontService) macaron.Handler {
 	return func(ctx *macaron.Context, flash *session.Flash, sess session.Store, info FormCityStudy) {
 		_, _, err := f.authenticateCharacterFromSession(ctx, sess, info.RegionID, info.CharacterID)
@@ -155,3 +160,26 @@ func doCityTransferResources(f *frontService) macaron.Handler {
 		ctx.Redirect("/game/land/overview?cid=" + info.CharacterID + "&lid=" + utoa(info.CityID))
 	}
 }
+
+func doCityClaim(f *frontService) macaron.Handler {
+	return func(ctx *macaron.Context, flash *session.Flash, sess session.Store, info FormCityClaim) {
+		_, _, err := f.authenticateCharacterFromSession(ctx, sess, info.RegionID, info.CharacterID)
+		if err != nil {
+			flash.Warning(err.Error())
+			ctx.Redirect("/game/user")
+			return
+		}
+
+		cliReg := region.NewCityClient(f.cnxRegion)
+		_, err = cliReg.Claim(contextMacaronToGrpc(ctx, sess),
+			&region.CityClaimReq{
+				Region:    info.RegionID,
+				Character: info.CharacterID,
+			})
+		if err != nil {
+			flash.Warning(err.Error())
+		}
+
+		ctx.Redirect("/game/character?reg=" + info.RegionID + "&cid=" + info.CharacterID)
+	}
+}
@@ -123,6 +123,9 @@ func Command() *cobra.Command {
 			m.Get("/action/logout", doLogout(&front))
 			m.Post("/action/move", doMove(&front))
 			m.Post("/action/produce", doProduce(&front))
+			m.Post("/action/schedule/pause", doSchedulePause(&front))
+			m.Post("/action/schedule/resume", doScheduleResume(&front))
+			m.Post("/action/city/claim", binding.Bind(FormCityClaim{}), doCityClaim(&front))
 			m.Post("/action/city/study", binding.Bind(FormCityStudy{}), doCityStudy(&front))
 			m.Post("/action/city/build", binding.Bind(FormCityBuild{}), doCityBuild(&front))
 			m.Post("/action/city/train", binding.Bind(FormCityTrain{}), doCityTrain(&front))
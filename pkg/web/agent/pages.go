@@ -131,6 +131,7 @@ func serveGameCharacter(f *frontService) ActionPage {
 		ctx.Data["Title"] = uView.Name + "|" + cView.Name
 		ctx.Data["userid"] = utoa(uView.Id)
 		ctx.Data["User"] = uView
+		ctx.Data["reg"] = cView.Region
 		ctx.Data["cid"] = utoa(cView.Id)
 		ctx.Data["Character"] = cView
 		ctx.Data["Cities"] = list.Items
@@ -39,7 +39,14 @@ func serveGameAdmin(f *frontService) ActionPage {
 			return t[i].Score > t[j].Score || (t[i].Score == t[j].Score && t[i].Id < t[j].Id)
 		})
 
+		schedule, err := cli.GetSchedule(contextMacaronToGrpc(ctx, sess), &region.RegionId{Region: ctx.Query("reg")})
+		if err != nil {
+			flash.Warning("Region error: " + err.Error())
+		}
+
 		ctx.Data["Scores"] = scoreBoard.Items
+		ctx.Data["Schedule"] = schedule
+		ctx.Data["reg"] = ctx.Query("reg")
 		ctx.Data["Title"] = uView.Name
 		ctx.Data["userid"] = utoa(uView.Id)
 		ctx.Data["User"] = uView
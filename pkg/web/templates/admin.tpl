@@ -1,5 +1,17 @@
 {% include "header.tpl" %}
 
+<div><h2>Schedule</h2>
+    {% if Schedule %}
+    <p>Movement every {{Schedule.MovePeriod}}s, {{Schedule.MoveTicks}} rounds played</p>
+    <p>Production every {{Schedule.ProducePeriod}}s, {{Schedule.ProduceTicks}} rounds played</p>
+    {% if Schedule.Paused %}
+    <form action="/action/schedule/resume?reg={{reg}}" method="post"><input type="submit" value="Resume"/></form>
+    {% else %}
+    <form action="/action/schedule/pause?reg={{reg}}" method="post"><input type="submit" value="Pause"/></form>
+    {% endif %}
+    {% endif %}
+</div>
+
 <div><h2>Production</h2>
     <form action="/action/produce" method="post"><input type="submit" value="Produce"/></form>
 </div>
@@ -6,6 +6,13 @@
         <li><a href="/game/land/overview?cid={{Character.Id}}&lid={{c.Id}}">{{c.Name}}</a></li>
         {% endfor %}
     </ul>
+    {% if not Cities %}
+    <form action="/action/city/claim" method="post">
+        <input type="hidden" name="reg" value="{{reg}}"/>
+        <input type="hidden" name="cid" value="{{cid}}"/>
+        <input type="submit" value="Claim a city"/>
+    </form>
+    {% endif %}
 </div>
 
 <div>